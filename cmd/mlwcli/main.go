@@ -1,10 +1,13 @@
 package main
 
 import (
+	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 
 	flags "github.com/jessevdk/go-flags"
+	"golang.org/x/term"
 
 	"github.com/goofansu/mlwcli/internal/app"
 	"github.com/goofansu/mlwcli/internal/auth"
@@ -12,11 +15,15 @@ import (
 )
 
 type Options struct {
-	Auth  AuthCommand  `command:"auth" description:"Authentication commands"`
-	Feed  FeedCommand  `command:"feed" description:"Manage feeds (miniflux)"`
-	Entry EntryCommand `command:"entry" description:"Manage feed entries (miniflux)"`
-	Link  LinkCommand  `command:"link" description:"Manage links (linkding)"`
-	Page  PageCommand  `command:"page" description:"Manage pages (wallabag)"`
+	Auth       AuthCommand       `command:"auth" description:"Authentication commands"`
+	Feed       FeedCommand       `command:"feed" description:"Manage feeds (miniflux)"`
+	Category   CategoryCommand   `command:"category" description:"Manage feed categories (miniflux)"`
+	Entry      EntryCommand      `command:"entry" description:"Manage feed entries (miniflux)"`
+	Link       LinkCommand       `command:"link" description:"Manage links (linkding)"`
+	Tag        TagCommand        `command:"tag" description:"Manage link tags (linkding)"`
+	Bundle     BundleCommand     `command:"bundle" description:"Manage link bundles (linkding)"`
+	Page       PageCommand       `command:"page" description:"Manage pages (wallabag)"`
+	Annotation AnnotationCommand `command:"annotation" description:"Manage page annotations (wallabag)"`
 }
 
 type BaseCommand struct {
@@ -28,6 +35,12 @@ type JSONOutputOptions struct {
 	JQ   string `long:"jq" value-name:"expression" description:"Filter JSON output using a jq expression (requires --json)"`
 }
 
+type AllPagesOptions struct {
+	All         bool `long:"all" description:"Fetch all pages of results (ignores --limit, --page and --per-page; --offset still applies)"`
+	Max         int  `long:"max" value-name:"n" description:"Maximum number of results with --all (0 for no limit)"`
+	Concurrency int  `long:"concurrency" value-name:"n" description:"Number of pages fetched in parallel with --all" default:"1"`
+}
+
 type AuthLoginCommand struct {
 	BaseCommand
 }
@@ -47,12 +60,133 @@ type FeedAddCommand struct {
 	Args struct {
 		URL string `positional-arg-name:"url" description:"URL of the feed to subscribe to" required:"yes"`
 	} `positional-args:"yes"`
-	CategoryID int64 `long:"category-id" description:"Miniflux category ID (defaults to 1)"`
+	CategoryID int64  `long:"category-id" description:"Miniflux category ID (defaults to 1)"`
+	Category   string `long:"category" value-name:"name" description:"Miniflux category name (created if missing)"`
+	Discover   bool   `long:"discover" description:"Treat url as a website and subscribe to the feed it advertises"`
+}
+
+type FeedDiscoverCommand struct {
+	BaseCommand
+	JSONOutputOptions
+	Args struct {
+		URL string `positional-arg-name:"site-url" description:"URL of the website to search for feeds" required:"yes"`
+	} `positional-args:"yes"`
 }
 
 type FeedListCommand struct {
 	BaseCommand
 	JSONOutputOptions
+	WithCounts bool `long:"with-counts" description:"Include unread and read entry counts for each feed"`
+}
+
+type FeedCountersCommand struct {
+	BaseCommand
+	JSONOutputOptions
+	ByCategory bool `long:"by-category" description:"Sum counts per category instead of per feed"`
+}
+
+type FeedHealthCommand struct {
+	BaseCommand
+	JSONOutputOptions
+	StaleDays int  `long:"stale-days" value-name:"days" description:"Report feeds without new entries for this many days (0 to disable)" default:"30"`
+	Fix       bool `long:"fix" description:"Refresh feeds with parsing errors before reporting"`
+	ExitCode  bool `long:"exit-code" description:"Exit with status 1 when unhealthy feeds are found"`
+}
+
+type FeedShowCommand struct {
+	BaseCommand
+	JSONOutputOptions
+	Args struct {
+		FeedID int64 `positional-arg-name:"feed-id" description:"ID of the feed to show" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+type FeedUpdateCommand struct {
+	BaseCommand
+	Args struct {
+		FeedID int64 `positional-arg-name:"feed-id" description:"ID of the feed to update" required:"yes"`
+	} `positional-args:"yes"`
+	Title        *string `long:"title" description:"Feed title"`
+	CategoryID   *int64  `long:"category-id" description:"Miniflux category ID"`
+	Category     string  `long:"category" value-name:"name" description:"Miniflux category name (created if missing)"`
+	Crawler      bool    `long:"crawler" description:"Fetch original content"`
+	NoCrawler    bool    `long:"no-crawler" description:"Do not fetch original content"`
+	ScraperRules *string `long:"scraper-rules" description:"Scraper rules (CSS selectors)"`
+	RewriteRules *string `long:"rewrite-rules" description:"Content rewrite rules"`
+	UserAgent    *string `long:"user-agent" description:"User agent used to fetch the feed"`
+	Disable      bool    `long:"disable" description:"Disable the feed"`
+	Enable       bool    `long:"enable" description:"Enable the feed"`
+}
+
+type FeedRefreshCommand struct {
+	BaseCommand
+	Args struct {
+		FeedID int64 `positional-arg-name:"feed-id" description:"ID of the feed to refresh"`
+	} `positional-args:"yes"`
+	All bool `long:"all" description:"Refresh all feeds"`
+}
+
+type FeedRemoveCommand struct {
+	BaseCommand
+	Args struct {
+		FeedID int64 `positional-arg-name:"feed-id" description:"ID of the feed to remove" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+type FeedImportCommand struct {
+	BaseCommand
+	JSONOutputOptions
+	Args struct {
+		File string `positional-arg-name:"file" description:"OPML file to import" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+type FeedExportCommand struct {
+	BaseCommand
+	Output string `short:"o" long:"output" value-name:"file" description:"Write OPML to file instead of stdout"`
+}
+
+type CategoryListCommand struct {
+	BaseCommand
+	JSONOutputOptions
+}
+
+type CategoryAddCommand struct {
+	BaseCommand
+	Args struct {
+		Title string `positional-arg-name:"title" description:"Title of the category" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+type CategoryRenameCommand struct {
+	BaseCommand
+	Args struct {
+		CategoryID int64  `positional-arg-name:"category-id" description:"ID of the category to rename" required:"yes"`
+		Title      string `positional-arg-name:"title" description:"New title of the category" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+type CategoryRemoveCommand struct {
+	BaseCommand
+	Args struct {
+		CategoryID int64 `positional-arg-name:"category-id" description:"ID of the category to remove" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+type CategoryMarkReadCommand struct {
+	BaseCommand
+	Args struct {
+		CategoryID int64 `positional-arg-name:"category-id" description:"ID of the category to mark as read" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+type CategoryCommand struct {
+	BaseCommand
+	List     CategoryListCommand     `command:"list" description:"List categories (miniflux)"`
+	Add      CategoryAddCommand      `command:"add" description:"Add a category (miniflux)"`
+	Rename   CategoryRenameCommand   `command:"rename" description:"Rename a category (miniflux)"`
+	Remove   CategoryRemoveCommand   `command:"remove" description:"Remove a category (miniflux)"`
+	MarkRead CategoryMarkReadCommand `command:"mark-read" description:"Mark all entries in a category as read (miniflux)"`
 }
 
 type LinkAddCommand struct {
@@ -62,212 +196,1350 @@ type LinkAddCommand struct {
 	} `positional-args:"yes"`
 	Notes string `long:"notes" description:"Optional notes for link"`
 	Tags  string `long:"tags" description:"Optional tags separated by spaces"`
+	Merge bool   `long:"merge" description:"Add tags and notes to the existing link if the URL is already saved"`
 }
 
 type EntryListCommand struct {
 	BaseCommand
 	JSONOutputOptions
-	Limit   int    `long:"limit" description:"Maximum number of results" default:"10"`
-	Offset  int    `long:"offset" description:"Number of results to skip" default:"0"`
-	Search  string `long:"search" description:"Search query text"`
-	Status  string `long:"status" value-name:"status" description:"Filter by feed entry status (read, unread, removed)" default:"unread"`
-	Starred bool   `long:"starred" description:"Filter by starred feed entries"`
-	FeedID  int64  `long:"feed-id" description:"Filter by feed ID"`
+	AllPagesOptions
+	Limit          int    `long:"limit" description:"Maximum number of results" default:"10"`
+	Offset         int    `long:"offset" description:"Number of results to skip" default:"0"`
+	Search         string `long:"search" description:"Search query text"`
+	Status         string `long:"status" value-name:"status" description:"Filter by feed entry status (read, unread, removed)" default:"unread"`
+	Starred        bool   `long:"starred" description:"Filter by starred feed entries"`
+	FeedID         int64  `long:"feed-id" description:"Filter by feed ID"`
+	CategoryID     int64  `long:"category-id" description:"Filter by category ID"`
+	Order          string `long:"order" value-name:"field" description:"Sort field (id, status, published_at, category_title, category_id, title, author)" default:"published_at"`
+	Direction      string `long:"direction" description:"Sort direction" choice:"asc" choice:"desc" default:"desc"`
+	After          string `long:"after" value-name:"date" description:"Entries published after date; same as --published-after (RFC3339, YYYY-MM-DD or relative like 7d)"`
+	Before         string `long:"before" value-name:"date" description:"Entries published before date (RFC3339, YYYY-MM-DD or relative like 7d)"`
+	PublishedAfter string `long:"published-after" value-name:"date" description:"Entries published after date (RFC3339, YYYY-MM-DD or relative like 7d)"`
+	ChangedAfter   string `long:"changed-after" value-name:"date" description:"Entries changed (read, starred) after date (RFC3339, YYYY-MM-DD or relative like 7d)"`
 }
 
 type LinkListCommand struct {
 	BaseCommand
 	JSONOutputOptions
-	Limit  int    `long:"limit" description:"Maximum number of results" default:"10"`
-	Offset int    `long:"offset" description:"Number of results to skip" default:"0"`
-	Search string `long:"search" description:"Search query text"`
+	AllPagesOptions
+	Limit      int      `long:"limit" description:"Maximum number of results" default:"10"`
+	Offset     int      `long:"offset" description:"Number of results to skip" default:"0"`
+	Search     string   `long:"search" description:"Search query text"`
+	Tags       []string `long:"tag" description:"Only links with this tag (repeatable)"`
+	Archived   bool     `long:"archived" description:"List archived links instead"`
+	Unread     bool     `long:"unread" description:"Only unread links"`
+	Shared     bool     `long:"shared" description:"Only shared links"`
+	AddedAfter string   `long:"added-after" value-name:"date" description:"Only links added after date (RFC3339, YYYY-MM-DD or relative like 7d)"`
+	Sort       string   `long:"sort" value-name:"order" description:"Sort order: added_asc, added_desc, title_asc or title_desc"`
+	Bundle     string   `long:"bundle" value-name:"name" description:"Only links matching this bundle (name or ID)"`
 }
 
-type EntrySaveCommand struct {
+type LinkShowCommand struct {
 	BaseCommand
+	JSONOutputOptions
 	Args struct {
-		EntryID int64 `positional-arg-name:"entry-id" description:"ID of the entry to save" required:"yes"`
+		ID int `positional-arg-name:"link-id" description:"ID of the link to show" required:"yes"`
 	} `positional-args:"yes"`
 }
 
-type LinkCommand struct {
+type LinkUpdateCommand struct {
 	BaseCommand
-	Add  LinkAddCommand  `command:"add" description:"Add a link (linkding)"`
-	List LinkListCommand `command:"list" description:"List links (linkding)"`
+	Args struct {
+		ID int `positional-arg-name:"link-id" description:"ID of the link to update" required:"yes"`
+	} `positional-args:"yes"`
+	Title       *string `long:"title" description:"Link title"`
+	Description *string `long:"description" description:"Link description"`
+	Notes       *string `long:"notes" description:"Link notes"`
+	Tags        *string `long:"tags" description:"Replace all tags (separated by spaces)"`
+	AddTags     string  `long:"add-tags" description:"Tags to add (separated by spaces)"`
+	RemoveTags  string  `long:"remove-tags" description:"Tags to remove (separated by spaces)"`
+	Unread      bool    `long:"unread" description:"Mark as unread"`
+	Read        bool    `long:"read" description:"Mark as read"`
+	Shared      bool    `long:"shared" description:"Share the link"`
+	Unshared    bool    `long:"unshared" description:"Stop sharing the link"`
 }
 
-type EntryCommand struct {
+type LinkIDArgs struct {
+	ID int `positional-arg-name:"link-id" description:"ID of the link" required:"yes"`
+}
+
+type LinkDeleteCommand struct {
 	BaseCommand
-	List EntryListCommand `command:"list" description:"List feed entries (miniflux)"`
-	Save EntrySaveCommand `command:"save" description:"Save an entry (miniflux)"`
+	Args LinkIDArgs `positional-args:"yes"`
 }
 
-type FeedCommand struct {
+type LinkArchiveCommand struct {
 	BaseCommand
-	Add  FeedAddCommand  `command:"add" description:"Add a feed (miniflux)"`
-	List FeedListCommand `command:"list" description:"List feeds (miniflux)"`
+	Args LinkIDArgs `positional-args:"yes"`
 }
 
-type PageAddCommand struct {
+type LinkUnarchiveCommand struct {
 	BaseCommand
+	Args LinkIDArgs `positional-args:"yes"`
+}
+
+type LinkImportCommand struct {
+	BaseCommand
+	JSONOutputOptions
 	Args struct {
-		URL string `positional-arg-name:"url" description:"URL of the page to add" required:"yes"`
+		File string `positional-arg-name:"file" description:"Netscape bookmark HTML file to import" required:"yes"`
 	} `positional-args:"yes"`
-	Tags    string `long:"tags" description:"Tags separated by spaces"`
-	Archive bool   `long:"archive" description:"Mark as archived"`
+	Concurrency int `long:"concurrency" value-name:"n" description:"Number of links created in parallel" default:"4"`
 }
 
-type PageListCommand struct {
+type LinkExportCommand struct {
+	BaseCommand
+	Format string `long:"format" description:"Export format" choice:"netscape" choice:"json" choice:"csv" default:"netscape"`
+	Output string `short:"o" long:"output" value-name:"file" description:"Write links to file instead of stdout"`
+}
+
+type LinkAssetsCommand struct {
 	BaseCommand
 	JSONOutputOptions
-	Archive bool   `long:"archive" description:"Filter by archived status"`
-	Starred bool   `long:"starred" description:"Filter by starred status"`
-	Page    int    `long:"page" description:"Page number" default:"1"`
-	PerPage int    `long:"per-page" description:"Items per page" default:"10"`
-	Tags    string `long:"tags" description:"Tags separated by spaces"`
-	Domain  string `long:"domain" description:"Filter by domain name"`
+	Args LinkIDArgs `positional-args:"yes"`
 }
 
-type PageCommand struct {
+type LinkAssetCommand struct {
+	Download LinkAssetDownloadCommand `command:"download" description:"Download a link asset (linkding)"`
+	Upload   LinkAssetUploadCommand   `command:"upload" description:"Upload a file as a link asset (linkding)"`
+}
+
+type LinkAssetDownloadCommand struct {
 	BaseCommand
-	Add  PageAddCommand  `command:"add" description:"Add a page (wallabag)"`
-	List PageListCommand `command:"list" description:"List pages (wallabag)"`
+	Args struct {
+		LinkID  int `positional-arg-name:"link-id" description:"ID of the link" required:"yes"`
+		AssetID int `positional-arg-name:"asset-id" description:"ID of the asset to download" required:"yes"`
+	} `positional-args:"yes"`
+	Output string `short:"o" long:"output" value-name:"file" description:"Write the asset to file instead of stdout"`
 }
 
-func (c *AuthLoginCommand) Execute(_ []string) error {
-	return auth.Login()
+type LinkAssetUploadCommand struct {
+	BaseCommand
+	Args struct {
+		LinkID int    `positional-arg-name:"link-id" description:"ID of the link" required:"yes"`
+		File   string `positional-arg-name:"file" description:"File to upload, such as a PDF or HTML archive" required:"yes"`
+	} `positional-args:"yes"`
 }
 
-func (c *AuthLogoutCommand) Execute(_ []string) error {
-	return auth.Logout()
+type BundleListCommand struct {
+	BaseCommand
+	JSONOutputOptions
 }
 
-func (c *FeedAddCommand) Execute(_ []string) error {
-	opts := app.AddFeedOptions{
-		URL:        c.Args.URL,
-		CategoryID: c.CategoryID,
-	}
+type BundleShowCommand struct {
+	BaseCommand
+	JSONOutputOptions
+	Args struct {
+		Bundle string `positional-arg-name:"bundle" description:"Name or ID of the bundle to show" required:"yes"`
+	} `positional-args:"yes"`
+}
 
-	return c.App.AddFeed(opts)
+type BundleCreateCommand struct {
+	BaseCommand
+	Args struct {
+		Name string `positional-arg-name:"name" description:"Name of the bundle" required:"yes"`
+	} `positional-args:"yes"`
+	Search       string `long:"search" description:"Search query text"`
+	AnyTags      string `long:"any-tags" description:"Links with any of these tags (separated by spaces)"`
+	AllTags      string `long:"all-tags" description:"Links with all of these tags (separated by spaces)"`
+	ExcludedTags string `long:"excluded-tags" description:"Links without these tags (separated by spaces)"`
 }
 
-func (c *FeedListCommand) Execute(_ []string) error {
-	opts := app.ListFeedsOptions{
-		JSON: c.JSON,
-		JQ:   c.JQ,
-	}
-	return c.App.ListFeeds(opts)
+type BundleDeleteCommand struct {
+	BaseCommand
+	Args struct {
+		Bundle string `positional-arg-name:"bundle" description:"Name or ID of the bundle to delete" required:"yes"`
+	} `positional-args:"yes"`
 }
 
-func (c *LinkAddCommand) Execute(_ []string) error {
-	opts := app.AddLinkOptions{
-		URL:   c.Args.URL,
-		Notes: c.Notes,
-		Tags:  c.Tags,
-	}
+type TagListCommand struct {
+	BaseCommand
+	JSONOutputOptions
+}
 
-	return c.App.AddLink(opts)
+type TagRenameCommand struct {
+	BaseCommand
+	Args struct {
+		Old string `positional-arg-name:"old" description:"Tag to rename" required:"yes"`
+		New string `positional-arg-name:"new" description:"New tag name" required:"yes"`
+	} `positional-args:"yes"`
 }
 
-func (c *EntryListCommand) Execute(_ []string) error {
-	starred := ""
-	if c.Starred {
-		starred = "1"
-	}
+type TagMergeCommand struct {
+	BaseCommand
+	Args struct {
+		Target  string   `positional-arg-name:"tag" description:"Tag to keep" required:"yes"`
+		Sources []string `positional-arg-name:"other" description:"Tags to merge into it" required:"1"`
+	} `positional-args:"yes"`
+}
 
-	opts := app.EntriesOptions{
-		FeedID:  c.FeedID,
-		Search:  c.Search,
-		Limit:   c.Limit,
-		Offset:  c.Offset,
-		Status:  c.Status,
-		Starred: starred,
-		JSON:    c.JSON,
-		JQ:      c.JQ,
-	}
+type TagPruneCommand struct {
+	BaseCommand
+	JSONOutputOptions
+}
 
-	return c.App.ListEntries(opts)
+type EntrySaveCommand struct {
+	BaseCommand
+	Args struct {
+		EntryID int64 `positional-arg-name:"entry-id" description:"ID of the entry to save" required:"yes"`
+	} `positional-args:"yes"`
 }
 
-func (c *LinkListCommand) Execute(_ []string) error {
-	opts := app.ListLinksOptions{
-		Query:  c.Search,
-		Limit:  c.Limit,
-		Offset: c.Offset,
-		JSON:   c.JSON,
-		JQ:     c.JQ,
-	}
-	return c.App.ListLinks(opts)
+type EntryShowCommand struct {
+	BaseCommand
+	Args struct {
+		EntryID int64 `positional-arg-name:"entry-id" description:"ID of the entry to show" required:"yes"`
+	} `positional-args:"yes"`
+	Format string `long:"format" description:"Output format" choice:"text" choice:"markdown" choice:"html" default:"text"`
 }
 
-func (c *PageAddCommand) Execute(_ []string) error {
-	opts := app.AddPageOptions{
-		URL:     c.Args.URL,
-		Tags:    c.Tags,
-		Archive: c.Archive,
-	}
-	return c.App.AddPage(opts)
+type EntryMarkReadCommand struct {
+	BaseCommand
+	FeedID     int64  `long:"feed-id" description:"Mark entries of this feed as read"`
+	CategoryID int64  `long:"category-id" description:"Mark entries of this category as read"`
+	Before     string `long:"before" value-name:"date" description:"Only entries published before this date (RFC3339, YYYY-MM-DD or relative like 7d)"`
+	Search     string `long:"search" description:"Only entries matching this search query"`
+	All        bool   `long:"all" description:"Mark all unread entries as read"`
+	DryRun     bool   `long:"dry-run" description:"Only report how many entries would be marked as read"`
 }
 
-func (c *PageListCommand) Execute(_ []string) error {
-	archive := -1
-	if c.Archive {
-		archive = 1
-	}
+type EntryIDsArgs struct {
+	EntryIDs []int64 `positional-arg-name:"entry-id" description:"IDs of the entries (read from stdin when omitted)"`
+}
 
-	starred := -1
-	if c.Starred {
-		starred = 1
-	}
+type EntryReadCommand struct {
+	BaseCommand
+	Args EntryIDsArgs `positional-args:"yes"`
+}
 
-	opts := app.ListPagesOptions{
-		Archive: archive,
-		Starred: starred,
-		Page:    c.Page,
-		PerPage: c.PerPage,
-		Tags:    c.Tags,
-		Domain:  c.Domain,
-		JSON:    c.JSON,
-		JQ:      c.JQ,
-	}
+type EntryUnreadCommand struct {
+	BaseCommand
+	Args EntryIDsArgs `positional-args:"yes"`
+}
 
-	return c.App.ListPages(opts)
+type EntryStarCommand struct {
+	BaseCommand
+	Args EntryIDsArgs `positional-args:"yes"`
 }
 
-func (c *FeedAddCommand) Usage() string {
-	return "<url>"
+type EntryUnstarCommand struct {
+	BaseCommand
+	Args EntryIDsArgs `positional-args:"yes"`
 }
 
-func (c *FeedListCommand) Usage() string {
-	return "[OPTIONS]"
+type LinkCommand struct {
+	BaseCommand
+	Add       LinkAddCommand       `command:"add" description:"Add a link (linkding)"`
+	List      LinkListCommand      `command:"list" description:"List links (linkding)"`
+	Show      LinkShowCommand      `command:"show" description:"Show a link (linkding)"`
+	Update    LinkUpdateCommand    `command:"update" description:"Update a link (linkding)"`
+	Delete    LinkDeleteCommand    `command:"delete" description:"Delete a link (linkding)"`
+	Archive   LinkArchiveCommand   `command:"archive" description:"Archive a link (linkding)"`
+	Unarchive LinkUnarchiveCommand `command:"unarchive" description:"Unarchive a link (linkding)"`
+	Import    LinkImportCommand    `command:"import" description:"Import links from a browser bookmarks file (linkding)"`
+	Export    LinkExportCommand    `command:"export" description:"Export links as bookmarks HTML, JSON or CSV (linkding)"`
+	Assets    LinkAssetsCommand    `command:"assets" description:"List a link's snapshots and files (linkding)"`
+	Asset     LinkAssetCommand     `command:"asset" description:"Download or upload link assets (linkding)"`
 }
 
-func (c *LinkAddCommand) Usage() string {
-	return "<url>"
+type BundleCommand struct {
+	BaseCommand
+	List   BundleListCommand   `command:"list" description:"List bundles (linkding)"`
+	Show   BundleShowCommand   `command:"show" description:"Show a bundle (linkding)"`
+	Create BundleCreateCommand `command:"create" description:"Create a bundle (linkding)"`
+	Delete BundleDeleteCommand `command:"delete" description:"Delete a bundle (linkding)"`
 }
 
-func (c *EntryListCommand) Usage() string {
-	return "[OPTIONS]"
+type TagCommand struct {
+	BaseCommand
+	List   TagListCommand   `command:"list" description:"List tags with usage counts (linkding)"`
+	Rename TagRenameCommand `command:"rename" description:"Rename a tag on all links (linkding)"`
+	Merge  TagMergeCommand  `command:"merge" description:"Merge tags into the first one (linkding)"`
+	Prune  TagPruneCommand  `command:"prune" description:"List tags not used by any link (linkding)"`
 }
 
-func (c *EntrySaveCommand) Execute(_ []string) error {
-	return c.App.SaveEntry(c.Args.EntryID)
+type EntryCommand struct {
+	BaseCommand
+	List     EntryListCommand     `command:"list" description:"List feed entries (miniflux)"`
+	Show     EntryShowCommand     `command:"show" description:"Show an entry's content (miniflux)"`
+	Save     EntrySaveCommand     `command:"save" description:"Save an entry (miniflux)"`
+	Read     EntryReadCommand     `command:"read" description:"Mark entries as read (miniflux)"`
+	Unread   EntryUnreadCommand   `command:"unread" description:"Mark entries as unread (miniflux)"`
+	MarkRead EntryMarkReadCommand `command:"mark-read" description:"Mark entries matching filters as read (miniflux)"`
+	Star     EntryStarCommand     `command:"star" description:"Star entries (miniflux)"`
+	Unstar   EntryUnstarCommand   `command:"unstar" description:"Unstar entries (miniflux)"`
 }
 
-func (c *EntrySaveCommand) Usage() string {
-	return "<entry-id>"
+type FeedCommand struct {
+	BaseCommand
+	Add      FeedAddCommand      `command:"add" description:"Add a feed (miniflux)"`
+	List     FeedListCommand     `command:"list" description:"List feeds (miniflux)"`
+	Counters FeedCountersCommand `command:"counters" description:"Show unread and read counts per feed (miniflux)"`
+	Discover FeedDiscoverCommand `command:"discover" description:"Discover feeds of a website (miniflux)"`
+	Health   FeedHealthCommand   `command:"health" description:"Report failing, stale and disabled feeds (miniflux)"`
+	Show     FeedShowCommand     `command:"show" description:"Show a feed (miniflux)"`
+	Update   FeedUpdateCommand   `command:"update" description:"Update a feed (miniflux)"`
+	Refresh  FeedRefreshCommand  `command:"refresh" description:"Refresh feeds (miniflux)"`
+	Remove   FeedRemoveCommand   `command:"remove" description:"Remove a feed (miniflux)"`
+	Import   FeedImportCommand   `command:"import" description:"Import feeds from OPML (miniflux)"`
+	Export   FeedExportCommand   `command:"export" description:"Export feeds as OPML (miniflux)"`
 }
 
-func (c *LinkListCommand) Usage() string {
-	return "[OPTIONS]"
+type PageAddCommand struct {
+	BaseCommand
+	Args struct {
+		URL string `positional-arg-name:"url" description:"URL of the page to add" required:"yes"`
+	} `positional-args:"yes"`
+	Title       string `long:"title" description:"Page title"`
+	Tags        string `long:"tags" description:"Tags separated by spaces"`
+	Starred     bool   `long:"starred" description:"Mark as starred"`
+	Archive     bool   `long:"archive" description:"Mark as archived"`
+	ContentFile string `long:"content-file" value-name:"file" description:"HTML file to save as the page content instead of fetching the URL"`
+	Language    string `long:"language" description:"Page language (e.g. en, fr_FR)"`
+	PublishedAt string `long:"published-at" value-name:"date" description:"Publication date (RFC3339, YYYY-MM-DD or relative like 7d)"`
+	Authors     string `long:"authors" description:"Authors separated by commas"`
 }
 
-func (c *PageAddCommand) Usage() string {
-	return "<url>"
+type PageListCommand struct {
+	BaseCommand
+	JSONOutputOptions
+	AllPagesOptions
+	Archive bool   `long:"archive" description:"Filter by archived status"`
+	Starred bool   `long:"starred" description:"Filter by starred status"`
+	Page    int    `long:"page" description:"Page number" default:"1"`
+	PerPage int    `long:"per-page" description:"Items per page" default:"10"`
+	Tags    string `long:"tags" description:"Tags separated by spaces"`
+	Domain  string `long:"domain" description:"Filter by domain name"`
 }
 
-func (c *PageListCommand) Usage() string {
-	return "[OPTIONS]"
+type PageIDArgs struct {
+	ID int `positional-arg-name:"page-id" description:"ID of the page" required:"yes"`
+}
+
+type PageExistsCommand struct {
+	BaseCommand
+	JSONOutputOptions
+	Args struct {
+		URLs []string `positional-arg-name:"url" description:"URLs to check" required:"1"`
+	} `positional-args:"yes"`
+}
+
+type PageShowCommand struct {
+	BaseCommand
+	JSONOutputOptions
+	Args PageIDArgs `positional-args:"yes"`
+}
+
+type PageUpdateCommand struct {
+	BaseCommand
+	Args           PageIDArgs `positional-args:"yes"`
+	Title          *string    `long:"title" description:"Page title"`
+	Tags           string     `long:"tags" description:"Tags to add (separated by spaces)"`
+	Starred        bool       `long:"starred" description:"Star the page"`
+	Unstarred      bool       `long:"unstarred" description:"Unstar the page"`
+	Archive        bool       `long:"archive" description:"Archive the page"`
+	Unarchive      bool       `long:"unarchive" description:"Unarchive the page"`
+	Language       *string    `long:"language" description:"Page language (e.g. en, fr_FR)"`
+	PreviewPicture *string    `long:"preview-picture" value-name:"url" description:"URL of the preview picture"`
+}
+
+type PageReadCommand struct {
+	BaseCommand
+	Args     PageIDArgs `positional-args:"yes"`
+	Markdown bool       `long:"markdown" description:"Write Markdown instead of paged terminal text"`
+}
+
+type PageAnnotationsCommand struct {
+	BaseCommand
+	JSONOutputOptions
+	Args   PageIDArgs `positional-args:"yes"`
+	Export string     `long:"export" description:"Write annotations as a document in this format" choice:"markdown"`
+}
+
+type PageAnnotateCommand struct {
+	BaseCommand
+	Args  PageIDArgs `positional-args:"yes"`
+	Quote string     `long:"quote" description:"Text of the page to highlight" required:"yes"`
+	Text  string     `long:"text" description:"Note attached to the highlight"`
+}
+
+type AnnotationDeleteCommand struct {
+	BaseCommand
+	Args struct {
+		ID int `positional-arg-name:"annotation-id" description:"ID of the annotation to delete" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+type AnnotationCommand struct {
+	BaseCommand
+	Delete AnnotationDeleteCommand `command:"delete" description:"Delete an annotation (wallabag)"`
+}
+
+type PageExportCommand struct {
+	BaseCommand
+	Args struct {
+		ID int `positional-arg-name:"page-id" description:"ID of the page to export (omit to export all matching pages)"`
+	} `positional-args:"yes"`
+	Format  string `long:"format" description:"Export format" choice:"epub" choice:"mobi" choice:"pdf" choice:"csv" choice:"json" choice:"txt" choice:"xml" choice:"md" default:"epub"`
+	Output  string `short:"o" long:"output" value-name:"path" description:"Output file, or output directory when exporting several pages"`
+	Archive bool   `long:"archive" description:"Only archived pages"`
+	Starred bool   `long:"starred" description:"Only starred pages"`
+	Tags    string `long:"tags" description:"Only pages with these tags (separated by spaces)"`
+	Domain  string `long:"domain" description:"Only pages from this domain"`
+}
+
+type PageTagsCommand struct {
+	BaseCommand
+	JSONOutputOptions
+}
+
+type PageTagCommand struct {
+	Add    PageTagAddCommand    `command:"add" description:"Add tags to a page (wallabag)"`
+	Remove PageTagRemoveCommand `command:"remove" description:"Remove a tag from a page (wallabag)"`
+	Delete PageTagDeleteCommand `command:"delete" description:"Delete a tag from all pages (wallabag)"`
+}
+
+type PageTagAddCommand struct {
+	BaseCommand
+	Args struct {
+		ID   int      `positional-arg-name:"page-id" description:"ID of the page" required:"yes"`
+		Tags []string `positional-arg-name:"tag" description:"Tags to add" required:"1"`
+	} `positional-args:"yes"`
+}
+
+type PageTagRemoveCommand struct {
+	BaseCommand
+	Args struct {
+		ID  int    `positional-arg-name:"page-id" description:"ID of the page" required:"yes"`
+		Tag string `positional-arg-name:"tag" description:"Tag to remove" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+type PageTagDeleteCommand struct {
+	BaseCommand
+	Args struct {
+		Label string `positional-arg-name:"label" description:"Tag to delete" required:"yes"`
+	} `positional-args:"yes"`
+}
+
+type PageStarCommand struct {
+	BaseCommand
+	Args PageIDArgs `positional-args:"yes"`
+}
+
+type PageUnstarCommand struct {
+	BaseCommand
+	Args PageIDArgs `positional-args:"yes"`
+}
+
+type PageArchiveCommand struct {
+	BaseCommand
+	Args PageIDArgs `positional-args:"yes"`
+}
+
+type PageUnarchiveCommand struct {
+	BaseCommand
+	Args PageIDArgs `positional-args:"yes"`
+}
+
+type PageDeleteCommand struct {
+	BaseCommand
+	Args PageIDArgs `positional-args:"yes"`
+}
+
+type PageReloadCommand struct {
+	BaseCommand
+	Args PageIDArgs `positional-args:"yes"`
+}
+
+type PageCommand struct {
+	BaseCommand
+	Add         PageAddCommand         `command:"add" description:"Add a page (wallabag)"`
+	List        PageListCommand        `command:"list" description:"List pages (wallabag)"`
+	Exists      PageExistsCommand      `command:"exists" description:"Check whether URLs are already saved (wallabag)"`
+	Show        PageShowCommand        `command:"show" description:"Show a page (wallabag)"`
+	Read        PageReadCommand        `command:"read" description:"Read a page in the terminal (wallabag)"`
+	Update      PageUpdateCommand      `command:"update" description:"Update a page (wallabag)"`
+	Export      PageExportCommand      `command:"export" description:"Export pages as EPUB, PDF and more (wallabag)"`
+	Tags        PageTagsCommand        `command:"tags" description:"List page tags with usage counts (wallabag)"`
+	Tag         PageTagCommand         `command:"tag" description:"Add, remove or delete page tags (wallabag)"`
+	Annotations PageAnnotationsCommand `command:"annotations" description:"List a page's annotations (wallabag)"`
+	Annotate    PageAnnotateCommand    `command:"annotate" description:"Highlight and annotate a page (wallabag)"`
+	Star        PageStarCommand        `command:"star" description:"Star a page (wallabag)"`
+	Unstar      PageUnstarCommand      `command:"unstar" description:"Unstar a page (wallabag)"`
+	Archive     PageArchiveCommand     `command:"archive" description:"Archive a page (wallabag)"`
+	Unarchive   PageUnarchiveCommand   `command:"unarchive" description:"Unarchive a page (wallabag)"`
+	Delete      PageDeleteCommand      `command:"delete" description:"Delete a page (wallabag)"`
+	Reload      PageReloadCommand      `command:"reload" description:"Fetch the page content again (wallabag)"`
+}
+
+func (c *AuthLoginCommand) Execute(_ []string) error {
+	return auth.Login()
+}
+
+func (c *AuthLogoutCommand) Execute(_ []string) error {
+	return auth.Logout()
+}
+
+func (c *FeedAddCommand) Execute(_ []string) error {
+	opts := app.AddFeedOptions{
+		URL:        c.Args.URL,
+		CategoryID: c.CategoryID,
+		Category:   c.Category,
+		Discover:   c.Discover,
+	}
+
+	return c.App.AddFeed(opts)
+}
+
+func (c *FeedListCommand) Execute(_ []string) error {
+	opts := app.ListFeedsOptions{
+		WithCounts: c.WithCounts,
+		JSON:       c.JSON,
+		JQ:         c.JQ,
+	}
+	return c.App.ListFeeds(opts)
+}
+
+func (c *FeedCountersCommand) Execute(_ []string) error {
+	opts := app.FeedCountersOptions{
+		ByCategory: c.ByCategory,
+		JSON:       c.JSON,
+		JQ:         c.JQ,
+	}
+	return c.App.FeedCounters(opts)
+}
+
+func (c *FeedDiscoverCommand) Execute(_ []string) error {
+	opts := app.DiscoverFeedsOptions{
+		URL:  c.Args.URL,
+		JSON: c.JSON,
+		JQ:   c.JQ,
+	}
+	return c.App.DiscoverFeeds(opts)
+}
+
+func (c *FeedHealthCommand) Execute(_ []string) error {
+	opts := app.FeedHealthOptions{
+		StaleDays: c.StaleDays,
+		Fix:       c.Fix,
+		ExitCode:  c.ExitCode,
+		JSON:      c.JSON,
+		JQ:        c.JQ,
+	}
+	return c.App.FeedHealth(opts)
+}
+
+func (c *FeedShowCommand) Execute(_ []string) error {
+	opts := app.ShowFeedOptions{
+		FeedID: c.Args.FeedID,
+		JSON:   c.JSON,
+		JQ:     c.JQ,
+	}
+	return c.App.ShowFeed(opts)
+}
+
+func (c *FeedUpdateCommand) Execute(_ []string) error {
+	crawler, err := boolFlag(c.Crawler, c.NoCrawler, "--crawler", "--no-crawler")
+	if err != nil {
+		return err
+	}
+
+	disabled, err := boolFlag(c.Disable, c.Enable, "--disable", "--enable")
+	if err != nil {
+		return err
+	}
+
+	opts := app.UpdateFeedOptions{
+		FeedID:       c.Args.FeedID,
+		Title:        c.Title,
+		CategoryID:   c.CategoryID,
+		Category:     c.Category,
+		Crawler:      crawler,
+		ScraperRules: c.ScraperRules,
+		RewriteRules: c.RewriteRules,
+		UserAgent:    c.UserAgent,
+		Disabled:     disabled,
+	}
+	return c.App.UpdateFeed(opts)
+}
+
+func (c *FeedRefreshCommand) Execute(_ []string) error {
+	if c.All && c.Args.FeedID != 0 {
+		return fmt.Errorf("feed ID and --all cannot be used together")
+	}
+	if c.All {
+		return c.App.RefreshAllFeeds()
+	}
+	if c.Args.FeedID == 0 {
+		return fmt.Errorf("feed ID or --all is required")
+	}
+	return c.App.RefreshFeed(c.Args.FeedID)
+}
+
+func (c *FeedRemoveCommand) Execute(_ []string) error {
+	return c.App.RemoveFeed(c.Args.FeedID)
+}
+
+func (c *FeedImportCommand) Execute(_ []string) error {
+	opts := app.ImportFeedsOptions{
+		File: c.Args.File,
+		JSON: c.JSON,
+		JQ:   c.JQ,
+	}
+	return c.App.ImportFeeds(opts)
+}
+
+func (c *FeedExportCommand) Execute(_ []string) error {
+	opts := app.ExportFeedsOptions{
+		Output: c.Output,
+	}
+	return c.App.ExportFeeds(opts)
+}
+
+func (c *CategoryListCommand) Execute(_ []string) error {
+	opts := app.ListCategoriesOptions{
+		JSON: c.JSON,
+		JQ:   c.JQ,
+	}
+	return c.App.ListCategories(opts)
+}
+
+func (c *CategoryAddCommand) Execute(_ []string) error {
+	return c.App.AddCategory(c.Args.Title)
+}
+
+func (c *CategoryRenameCommand) Execute(_ []string) error {
+	return c.App.RenameCategory(c.Args.CategoryID, c.Args.Title)
+}
+
+func (c *CategoryRemoveCommand) Execute(_ []string) error {
+	return c.App.RemoveCategory(c.Args.CategoryID)
+}
+
+func (c *CategoryMarkReadCommand) Execute(_ []string) error {
+	return c.App.MarkCategoryAsRead(c.Args.CategoryID)
+}
+
+func (c *LinkAddCommand) Execute(_ []string) error {
+	opts := app.AddLinkOptions{
+		URL:   c.Args.URL,
+		Notes: c.Notes,
+		Tags:  c.Tags,
+		Merge: c.Merge,
+	}
+
+	return c.App.AddLink(opts)
+}
+
+func (c *EntryListCommand) Execute(_ []string) error {
+	starred := ""
+	if c.Starred {
+		starred = "1"
+	}
+
+	opts := app.EntriesOptions{
+		FeedID:         c.FeedID,
+		CategoryID:     c.CategoryID,
+		Search:         c.Search,
+		Limit:          c.Limit,
+		Offset:         c.Offset,
+		Status:         c.Status,
+		Starred:        starred,
+		Order:          c.Order,
+		Direction:      c.Direction,
+		After:          c.After,
+		Before:         c.Before,
+		PublishedAfter: c.PublishedAfter,
+		ChangedAfter:   c.ChangedAfter,
+		All:            c.All,
+		Max:            c.Max,
+		Concurrency:    c.Concurrency,
+		JSON:           c.JSON,
+		JQ:             c.JQ,
+	}
+
+	return c.App.ListEntries(opts)
+}
+
+func (c *LinkListCommand) Execute(_ []string) error {
+	opts := app.ListLinksOptions{
+		Query:       c.Search,
+		Tags:        c.Tags,
+		Archived:    c.Archived,
+		Unread:      c.Unread,
+		Shared:      c.Shared,
+		AddedAfter:  c.AddedAfter,
+		Sort:        c.Sort,
+		Bundle:      c.Bundle,
+		Limit:       c.Limit,
+		Offset:      c.Offset,
+		All:         c.All,
+		Max:         c.Max,
+		Concurrency: c.Concurrency,
+		JSON:        c.JSON,
+		JQ:          c.JQ,
+	}
+	return c.App.ListLinks(opts)
+}
+
+func (c *LinkShowCommand) Execute(_ []string) error {
+	opts := app.ShowLinkOptions{
+		ID:   c.Args.ID,
+		JSON: c.JSON,
+		JQ:   c.JQ,
+	}
+	return c.App.ShowLink(opts)
+}
+
+func (c *LinkUpdateCommand) Execute(_ []string) error {
+	unread, err := boolFlag(c.Unread, c.Read, "--unread", "--read")
+	if err != nil {
+		return err
+	}
+
+	shared, err := boolFlag(c.Shared, c.Unshared, "--shared", "--unshared")
+	if err != nil {
+		return err
+	}
+
+	opts := app.UpdateLinkOptions{
+		ID:          c.Args.ID,
+		Title:       c.Title,
+		Description: c.Description,
+		Notes:       c.Notes,
+		Tags:        c.Tags,
+		AddTags:     c.AddTags,
+		RemoveTags:  c.RemoveTags,
+		Unread:      unread,
+		Shared:      shared,
+	}
+	return c.App.UpdateLink(opts)
+}
+
+func (c *LinkDeleteCommand) Execute(_ []string) error {
+	return c.App.DeleteLink(c.Args.ID)
+}
+
+func (c *LinkArchiveCommand) Execute(_ []string) error {
+	return c.App.ArchiveLink(c.Args.ID)
+}
+
+func (c *LinkUnarchiveCommand) Execute(_ []string) error {
+	return c.App.UnarchiveLink(c.Args.ID)
+}
+
+func (c *LinkImportCommand) Execute(_ []string) error {
+	opts := app.ImportLinksOptions{
+		File:        c.Args.File,
+		Concurrency: c.Concurrency,
+		JSON:        c.JSON,
+		JQ:          c.JQ,
+	}
+	return c.App.ImportLinks(opts)
+}
+
+func (c *LinkExportCommand) Execute(_ []string) error {
+	opts := app.ExportLinksOptions{
+		Format: c.Format,
+		Output: c.Output,
+	}
+	return c.App.ExportLinks(opts)
+}
+
+func (c *LinkAssetsCommand) Execute(_ []string) error {
+	opts := app.ListLinkAssetsOptions{
+		ID:   c.Args.ID,
+		JSON: c.JSON,
+		JQ:   c.JQ,
+	}
+	return c.App.ListLinkAssets(opts)
+}
+
+func (c *LinkAssetDownloadCommand) Execute(_ []string) error {
+	opts := app.DownloadLinkAssetOptions{
+		LinkID:  c.Args.LinkID,
+		AssetID: c.Args.AssetID,
+		Output:  c.Output,
+	}
+	return c.App.DownloadLinkAsset(opts)
+}
+
+func (c *LinkAssetUploadCommand) Execute(_ []string) error {
+	return c.App.UploadLinkAsset(c.Args.LinkID, c.Args.File)
+}
+
+func (c *BundleListCommand) Execute(_ []string) error {
+	opts := app.ListBundlesOptions{
+		JSON: c.JSON,
+		JQ:   c.JQ,
+	}
+	return c.App.ListBundles(opts)
+}
+
+func (c *BundleShowCommand) Execute(_ []string) error {
+	opts := app.ShowBundleOptions{
+		Bundle: c.Args.Bundle,
+		JSON:   c.JSON,
+		JQ:     c.JQ,
+	}
+	return c.App.ShowBundle(opts)
+}
+
+func (c *BundleCreateCommand) Execute(_ []string) error {
+	opts := app.CreateBundleOptions{
+		Name:         c.Args.Name,
+		Search:       c.Search,
+		AnyTags:      c.AnyTags,
+		AllTags:      c.AllTags,
+		ExcludedTags: c.ExcludedTags,
+	}
+	return c.App.CreateBundle(opts)
+}
+
+func (c *BundleDeleteCommand) Execute(_ []string) error {
+	return c.App.DeleteBundle(c.Args.Bundle)
+}
+
+func (c *TagListCommand) Execute(_ []string) error {
+	opts := app.ListTagsOptions{
+		JSON: c.JSON,
+		JQ:   c.JQ,
+	}
+	return c.App.ListTags(opts)
+}
+
+func (c *TagRenameCommand) Execute(_ []string) error {
+	return c.App.RenameTag(c.Args.Old, c.Args.New)
+}
+
+func (c *TagMergeCommand) Execute(_ []string) error {
+	return c.App.MergeTags(c.Args.Target, c.Args.Sources)
+}
+
+func (c *TagPruneCommand) Execute(_ []string) error {
+	opts := app.PruneTagsOptions{
+		JSON: c.JSON,
+		JQ:   c.JQ,
+	}
+	return c.App.PruneTags(opts)
+}
+
+func (c *PageAddCommand) Execute(_ []string) error {
+	opts := app.AddPageOptions{
+		URL:         c.Args.URL,
+		Title:       c.Title,
+		Tags:        c.Tags,
+		Starred:     c.Starred,
+		Archive:     c.Archive,
+		ContentFile: c.ContentFile,
+		Language:    c.Language,
+		PublishedAt: c.PublishedAt,
+		Authors:     c.Authors,
+	}
+	return c.App.AddPage(opts)
+}
+
+func (c *PageListCommand) Execute(_ []string) error {
+	archive := -1
+	if c.Archive {
+		archive = 1
+	}
+
+	starred := -1
+	if c.Starred {
+		starred = 1
+	}
+
+	opts := app.ListPagesOptions{
+		Archive:     archive,
+		Starred:     starred,
+		Page:        c.Page,
+		PerPage:     c.PerPage,
+		Tags:        c.Tags,
+		Domain:      c.Domain,
+		All:         c.All,
+		Max:         c.Max,
+		Concurrency: c.Concurrency,
+		JSON:        c.JSON,
+		JQ:          c.JQ,
+	}
+
+	return c.App.ListPages(opts)
+}
+
+func (c *PageExistsCommand) Execute(_ []string) error {
+	opts := app.PagesExistOptions{
+		URLs: c.Args.URLs,
+		JSON: c.JSON,
+		JQ:   c.JQ,
+	}
+	return c.App.PagesExist(opts)
+}
+
+func (c *PageShowCommand) Execute(_ []string) error {
+	opts := app.ShowPageOptions{
+		ID:   c.Args.ID,
+		JSON: c.JSON,
+		JQ:   c.JQ,
+	}
+	return c.App.ShowPage(opts)
+}
+
+func (c *PageReadCommand) Execute(_ []string) error {
+	opts := app.ReadPageOptions{
+		ID:       c.Args.ID,
+		Markdown: c.Markdown,
+	}
+	return c.App.ReadPage(opts)
+}
+
+func (c *PageExportCommand) Execute(_ []string) error {
+	archive := -1
+	if c.Archive {
+		archive = 1
+	}
+
+	starred := -1
+	if c.Starred {
+		starred = 1
+	}
+
+	opts := app.ExportPagesOptions{
+		ID:      c.Args.ID,
+		Format:  c.Format,
+		Output:  c.Output,
+		Archive: archive,
+		Starred: starred,
+		Tags:    c.Tags,
+		Domain:  c.Domain,
+	}
+	return c.App.ExportPages(opts)
+}
+
+func (c *PageTagsCommand) Execute(_ []string) error {
+	opts := app.ListPageTagsOptions{
+		JSON: c.JSON,
+		JQ:   c.JQ,
+	}
+	return c.App.ListPageTags(opts)
+}
+
+func (c *PageTagAddCommand) Execute(_ []string) error {
+	return c.App.AddPageTags(c.Args.ID, c.Args.Tags)
+}
+
+func (c *PageTagRemoveCommand) Execute(_ []string) error {
+	return c.App.RemovePageTag(c.Args.ID, c.Args.Tag)
+}
+
+func (c *PageTagDeleteCommand) Execute(_ []string) error {
+	return c.App.DeletePageTag(c.Args.Label)
+}
+
+func (c *PageAnnotationsCommand) Execute(_ []string) error {
+	opts := app.ListAnnotationsOptions{
+		PageID: c.Args.ID,
+		Export: c.Export,
+		JSON:   c.JSON,
+		JQ:     c.JQ,
+	}
+	return c.App.ListAnnotations(opts)
+}
+
+func (c *PageAnnotateCommand) Execute(_ []string) error {
+	opts := app.AnnotatePageOptions{
+		PageID: c.Args.ID,
+		Quote:  c.Quote,
+		Text:   c.Text,
+	}
+	return c.App.AnnotatePage(opts)
+}
+
+func (c *AnnotationDeleteCommand) Execute(_ []string) error {
+	return c.App.DeleteAnnotation(c.Args.ID)
+}
+
+func (c *PageUpdateCommand) Execute(_ []string) error {
+	starred, err := boolFlag(c.Starred, c.Unstarred, "--starred", "--unstarred")
+	if err != nil {
+		return err
+	}
+
+	archive, err := boolFlag(c.Archive, c.Unarchive, "--archive", "--unarchive")
+	if err != nil {
+		return err
+	}
+
+	opts := app.UpdatePageOptions{
+		ID:             c.Args.ID,
+		Title:          c.Title,
+		Tags:           c.Tags,
+		Starred:        starred,
+		Archive:        archive,
+		Language:       c.Language,
+		PreviewPicture: c.PreviewPicture,
+	}
+	return c.App.UpdatePage(opts)
+}
+
+func (c *PageStarCommand) Execute(_ []string) error {
+	return c.App.StarPage(c.Args.ID, true)
+}
+
+func (c *PageUnstarCommand) Execute(_ []string) error {
+	return c.App.StarPage(c.Args.ID, false)
+}
+
+func (c *PageArchiveCommand) Execute(_ []string) error {
+	return c.App.ArchivePage(c.Args.ID, true)
+}
+
+func (c *PageUnarchiveCommand) Execute(_ []string) error {
+	return c.App.ArchivePage(c.Args.ID, false)
+}
+
+func (c *PageDeleteCommand) Execute(_ []string) error {
+	return c.App.DeletePage(c.Args.ID)
+}
+
+func (c *PageReloadCommand) Execute(_ []string) error {
+	return c.App.ReloadPage(c.Args.ID)
+}
+
+func (c *FeedAddCommand) Usage() string {
+	return "<url>"
+}
+
+func (c *FeedListCommand) Usage() string {
+	return "[OPTIONS]"
+}
+
+func (c *FeedCountersCommand) Usage() string {
+	return "[OPTIONS]"
+}
+
+func (c *FeedDiscoverCommand) Usage() string {
+	return "<site-url>"
+}
+
+func (c *FeedHealthCommand) Usage() string {
+	return "[OPTIONS]"
+}
+
+func (c *FeedShowCommand) Usage() string {
+	return "<feed-id>"
+}
+
+func (c *FeedUpdateCommand) Usage() string {
+	return "<feed-id> [OPTIONS]"
+}
+
+func (c *FeedRefreshCommand) Usage() string {
+	return "[<feed-id> | --all]"
+}
+
+func (c *FeedRemoveCommand) Usage() string {
+	return "<feed-id>"
+}
+
+func (c *FeedImportCommand) Usage() string {
+	return "<file.opml>"
+}
+
+func (c *FeedExportCommand) Usage() string {
+	return "[-o file]"
+}
+
+func (c *CategoryListCommand) Usage() string {
+	return "[OPTIONS]"
+}
+
+func (c *CategoryAddCommand) Usage() string {
+	return "<title>"
+}
+
+func (c *CategoryRenameCommand) Usage() string {
+	return "<category-id> <title>"
+}
+
+func (c *CategoryRemoveCommand) Usage() string {
+	return "<category-id>"
+}
+
+func (c *CategoryMarkReadCommand) Usage() string {
+	return "<category-id>"
+}
+
+func (c *LinkAddCommand) Usage() string {
+	return "<url>"
+}
+
+func (c *EntryListCommand) Usage() string {
+	return "[OPTIONS]"
+}
+
+func (c *EntrySaveCommand) Execute(_ []string) error {
+	return c.App.SaveEntry(c.Args.EntryID)
+}
+
+func (c *EntrySaveCommand) Usage() string {
+	return "<entry-id>"
+}
+
+func (c *EntryShowCommand) Execute(_ []string) error {
+	opts := app.ShowEntryOptions{
+		EntryID: c.Args.EntryID,
+		Format:  c.Format,
+	}
+	return c.App.ShowEntry(opts)
+}
+
+func (c *EntryShowCommand) Usage() string {
+	return "<entry-id>"
+}
+
+func (c *EntryReadCommand) Execute(_ []string) error {
+	entryIDs, err := readIDs(c.Args.EntryIDs)
+	if err != nil {
+		return err
+	}
+	return c.App.UpdateEntriesStatus(entryIDs, "read")
+}
+
+func (c *EntryReadCommand) Usage() string {
+	return "<entry-id>..."
+}
+
+func (c *EntryUnreadCommand) Execute(_ []string) error {
+	entryIDs, err := readIDs(c.Args.EntryIDs)
+	if err != nil {
+		return err
+	}
+	return c.App.UpdateEntriesStatus(entryIDs, "unread")
+}
+
+func (c *EntryUnreadCommand) Usage() string {
+	return "<entry-id>..."
+}
+
+func (c *EntryMarkReadCommand) Execute(_ []string) error {
+	opts := app.MarkEntriesReadOptions{
+		FeedID:     c.FeedID,
+		CategoryID: c.CategoryID,
+		Before:     c.Before,
+		Search:     c.Search,
+		All:        c.All,
+		DryRun:     c.DryRun,
+	}
+	return c.App.MarkEntriesRead(opts)
+}
+
+func (c *EntryMarkReadCommand) Usage() string {
+	return "[OPTIONS]"
+}
+
+func (c *EntryStarCommand) Execute(_ []string) error {
+	entryIDs, err := readIDs(c.Args.EntryIDs)
+	if err != nil {
+		return err
+	}
+	return c.App.StarEntries(entryIDs, true)
+}
+
+func (c *EntryStarCommand) Usage() string {
+	return "<entry-id>..."
+}
+
+func (c *EntryUnstarCommand) Execute(_ []string) error {
+	entryIDs, err := readIDs(c.Args.EntryIDs)
+	if err != nil {
+		return err
+	}
+	return c.App.StarEntries(entryIDs, false)
+}
+
+func (c *EntryUnstarCommand) Usage() string {
+	return "<entry-id>..."
+}
+
+func (c *LinkListCommand) Usage() string {
+	return "[OPTIONS]"
+}
+
+func (c *LinkShowCommand) Usage() string {
+	return "<link-id>"
+}
+
+func (c *LinkUpdateCommand) Usage() string {
+	return "<link-id> [OPTIONS]"
+}
+
+func (c *LinkDeleteCommand) Usage() string {
+	return "<link-id>"
+}
+
+func (c *LinkArchiveCommand) Usage() string {
+	return "<link-id>"
+}
+
+func (c *LinkUnarchiveCommand) Usage() string {
+	return "<link-id>"
+}
+
+func (c *LinkImportCommand) Usage() string {
+	return "<bookmarks.html>"
+}
+
+func (c *LinkAssetsCommand) Usage() string {
+	return "<link-id>"
+}
+
+func (c *LinkAssetDownloadCommand) Usage() string {
+	return "<link-id> <asset-id> [OPTIONS]"
+}
+
+func (c *LinkAssetUploadCommand) Usage() string {
+	return "<link-id> <file>"
+}
+
+func (c *BundleShowCommand) Usage() string {
+	return "<bundle>"
+}
+
+func (c *BundleCreateCommand) Usage() string {
+	return "<name> [OPTIONS]"
+}
+
+func (c *BundleDeleteCommand) Usage() string {
+	return "<bundle>"
+}
+
+func (c *TagRenameCommand) Usage() string {
+	return "<old> <new>"
+}
+
+func (c *TagMergeCommand) Usage() string {
+	return "<tag> <other>..."
+}
+
+func (c *PageAddCommand) Usage() string {
+	return "<url>"
+}
+
+func (c *PageListCommand) Usage() string {
+	return "[OPTIONS]"
+}
+
+func (c *PageExistsCommand) Usage() string {
+	return "<url>..."
+}
+
+func (c *PageShowCommand) Usage() string {
+	return "<page-id>"
+}
+
+func (c *PageReadCommand) Usage() string {
+	return "<page-id> [OPTIONS]"
+}
+
+func (c *PageExportCommand) Usage() string {
+	return "[page-id] [OPTIONS]"
+}
+
+func (c *PageTagAddCommand) Usage() string {
+	return "<page-id> <tag>..."
+}
+
+func (c *PageTagRemoveCommand) Usage() string {
+	return "<page-id> <tag>"
+}
+
+func (c *PageTagDeleteCommand) Usage() string {
+	return "<label>"
+}
+
+func (c *PageAnnotationsCommand) Usage() string {
+	return "<page-id> [OPTIONS]"
+}
+
+func (c *PageAnnotateCommand) Usage() string {
+	return "<page-id> --quote=<text> [OPTIONS]"
+}
+
+func (c *AnnotationDeleteCommand) Usage() string {
+	return "<annotation-id>"
+}
+
+func (c *PageUpdateCommand) Usage() string {
+	return "<page-id> [OPTIONS]"
+}
+
+func (c *PageStarCommand) Usage() string {
+	return "<page-id>"
+}
+
+func (c *PageUnstarCommand) Usage() string {
+	return "<page-id>"
+}
+
+func (c *PageArchiveCommand) Usage() string {
+	return "<page-id>"
+}
+
+func (c *PageUnarchiveCommand) Usage() string {
+	return "<page-id>"
+}
+
+func (c *PageDeleteCommand) Usage() string {
+	return "<page-id>"
+}
+
+func (c *PageReloadCommand) Usage() string {
+	return "<page-id>"
+}
+
+// boolFlag combines a pair of opposing flags into an optional value, which is
+// nil when neither flag was given.
+func boolFlag(on, off bool, onName, offName string) (*bool, error) {
+	if on && off {
+		return nil, fmt.Errorf("%s and %s cannot be used together", onName, offName)
+	}
+	if !on && !off {
+		return nil, nil
+	}
+	return &on, nil
+}
+
+// readIDs returns ids as is, or reads whitespace-separated IDs from stdin
+// when none were given on the command line and stdin is not a terminal.
+func readIDs(ids []int64) ([]int64, error) {
+	if len(ids) > 0 {
+		return ids, nil
+	}
+
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		return nil, fmt.Errorf("at least one ID is required")
+	}
+
+	scanner := bufio.NewScanner(os.Stdin)
+	scanner.Split(bufio.ScanWords)
+	for scanner.Scan() {
+		id, err := strconv.ParseInt(scanner.Text(), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ID %q: %w", scanner.Text(), err)
+		}
+		ids = append(ids, id)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read IDs from stdin: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("at least one ID is required")
+	}
+
+	return ids, nil
 }
 
 func main() {
@@ -286,12 +1558,68 @@ func main() {
 	opts.Auth.Logout.App = application
 	opts.Link.Add.App = application
 	opts.Link.List.App = application
+	opts.Link.Show.App = application
+	opts.Link.Update.App = application
+	opts.Link.Delete.App = application
+	opts.Link.Archive.App = application
+	opts.Link.Unarchive.App = application
+	opts.Link.Import.App = application
+	opts.Link.Export.App = application
+	opts.Link.Assets.App = application
+	opts.Link.Asset.Download.App = application
+	opts.Link.Asset.Upload.App = application
+	opts.Bundle.List.App = application
+	opts.Bundle.Show.App = application
+	opts.Bundle.Create.App = application
+	opts.Bundle.Delete.App = application
+	opts.Tag.List.App = application
+	opts.Tag.Rename.App = application
+	opts.Tag.Merge.App = application
+	opts.Tag.Prune.App = application
 	opts.Feed.Add.App = application
 	opts.Feed.List.App = application
+	opts.Feed.Counters.App = application
+	opts.Feed.Discover.App = application
+	opts.Feed.Health.App = application
+	opts.Feed.Show.App = application
+	opts.Feed.Update.App = application
+	opts.Feed.Refresh.App = application
+	opts.Feed.Remove.App = application
+	opts.Feed.Import.App = application
+	opts.Feed.Export.App = application
+	opts.Category.List.App = application
+	opts.Category.Add.App = application
+	opts.Category.Rename.App = application
+	opts.Category.Remove.App = application
+	opts.Category.MarkRead.App = application
 	opts.Entry.List.App = application
+	opts.Entry.Show.App = application
 	opts.Entry.Save.App = application
+	opts.Entry.Read.App = application
+	opts.Entry.Unread.App = application
+	opts.Entry.MarkRead.App = application
+	opts.Entry.Star.App = application
+	opts.Entry.Unstar.App = application
 	opts.Page.Add.App = application
 	opts.Page.List.App = application
+	opts.Page.Exists.App = application
+	opts.Page.Show.App = application
+	opts.Page.Read.App = application
+	opts.Page.Update.App = application
+	opts.Page.Export.App = application
+	opts.Page.Tags.App = application
+	opts.Page.Tag.Add.App = application
+	opts.Page.Tag.Remove.App = application
+	opts.Page.Tag.Delete.App = application
+	opts.Page.Annotations.App = application
+	opts.Page.Annotate.App = application
+	opts.Annotation.Delete.App = application
+	opts.Page.Star.App = application
+	opts.Page.Unstar.App = application
+	opts.Page.Archive.App = application
+	opts.Page.Unarchive.App = application
+	opts.Page.Delete.App = application
+	opts.Page.Reload.App = application
 
 	parser := flags.NewParser(&opts, flags.HelpFlag|flags.PassDoubleDash)
 	parser.ShortDescription = "mlwcli - Manage Miniflux, Linkding, and Wallabag"
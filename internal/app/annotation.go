@@ -0,0 +1,91 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goofansu/mlwcli/internal/format"
+	"github.com/goofansu/mlwcli/internal/wallabag"
+)
+
+type ListAnnotationsOptions struct {
+	PageID int
+	Export string
+	JSON   string
+	JQ     string
+}
+
+type AnnotatePageOptions struct {
+	PageID int
+	Quote  string
+	Text   string
+}
+
+func (a *App) ListAnnotations(opts ListAnnotationsOptions) error {
+	a.loadWallabag()
+
+	annotations, err := wallabag.GetAnnotations(opts.PageID)
+	if err != nil {
+		return err
+	}
+
+	if opts.Export != "markdown" {
+		data := map[string]any{
+			"total": annotations.Total,
+			"items": annotations.Rows,
+		}
+		return format.Output(data, opts.JSON, opts.JQ)
+	}
+
+	item, err := wallabag.GetEntry(opts.PageID)
+	if err != nil {
+		return err
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s\n\n<%s>\n", item.Title, item.URL)
+	for _, annotation := range annotations.Rows {
+		sb.WriteString("\n")
+		for _, line := range strings.Split(strings.TrimSpace(annotation.Quote), "\n") {
+			fmt.Fprintf(&sb, "> %s\n", strings.TrimSpace(line))
+		}
+		if text := strings.TrimSpace(annotation.Text); text != "" {
+			fmt.Fprintf(&sb, "\n%s\n", text)
+		}
+	}
+
+	fmt.Print(sb.String())
+	return nil
+}
+
+func (a *App) AnnotatePage(opts AnnotatePageOptions) error {
+	a.loadWallabag()
+
+	item, err := wallabag.GetEntry(opts.PageID)
+	if err != nil {
+		return err
+	}
+
+	annotation, err := wallabag.CreateAnnotation(opts.PageID, item.Content, opts.Quote, opts.Text)
+	if err != nil {
+		return err
+	}
+
+	if len(annotation.Ranges) == 0 {
+		fmt.Printf("Quote not found in page %d, the annotation will not be highlighted\n", opts.PageID)
+	}
+
+	fmt.Printf("✓ Annotation created successfully (ID: %d)\n", annotation.ID)
+	return nil
+}
+
+func (a *App) DeleteAnnotation(id int) error {
+	a.loadWallabag()
+
+	if err := wallabag.DeleteAnnotation(id); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Annotation %d deleted successfully\n", id)
+	return nil
+}
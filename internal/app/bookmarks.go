@@ -0,0 +1,219 @@
+package app
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goofansu/mlwcli/internal/format"
+	"github.com/goofansu/mlwcli/internal/linkding"
+	"github.com/goofansu/mlwcli/internal/netscape"
+	api "github.com/piero-vic/go-linkding"
+)
+
+type ImportLinksOptions struct {
+	File        string
+	Concurrency int
+	JSON        string
+	JQ          string
+}
+
+type ExportLinksOptions struct {
+	Format string
+	Output string
+}
+
+type linkImportResult struct {
+	URL     string     `json:"url"`
+	Title   string     `json:"title"`
+	Tags    []string   `json:"tags"`
+	AddDate *time.Time `json:"add_date,omitempty"`
+	Status  string     `json:"status"`
+	LinkID  int        `json:"link_id,omitempty"`
+	Error   string     `json:"error,omitempty"`
+}
+
+// ImportLinks creates a link for every bookmark in a Netscape bookmark file.
+// Linkding's API does not accept an add date, so links get the import time;
+// the original date is kept in the report. Bookmarks not marked private are
+// shared, but only when the file marks any as private: browsers never write
+// the attribute, and their bookmarks should not all become public.
+func (a *App) ImportLinks(opts ImportLinksOptions) error {
+	f, err := os.Open(opts.File)
+	if err != nil {
+		return fmt.Errorf("failed to open bookmarks file: %w", err)
+	}
+	defer f.Close()
+
+	bookmarks, err := netscape.Parse(f)
+	if err != nil {
+		return err
+	}
+
+	hasPrivate := slices.ContainsFunc(bookmarks, func(b netscape.Bookmark) bool { return b.Private })
+
+	existing, err := linkding.EveryBookmark(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, opts.Concurrency)
+	if err != nil {
+		return fmt.Errorf("failed to list links: %w", err)
+	}
+	saved := map[string]int{}
+	for _, bookmark := range existing {
+		saved[bookmark.URL] = bookmark.ID
+	}
+
+	results := make([]linkImportResult, len(bookmarks))
+	var pending []int
+	for i, b := range bookmarks {
+		results[i] = linkImportResult{
+			URL:   b.URL,
+			Title: b.Title,
+			Tags:  b.Tags,
+		}
+		if !b.AddDate.IsZero() {
+			results[i].AddDate = &b.AddDate
+		}
+		if linkID, ok := saved[b.URL]; ok {
+			results[i].Status = "skipped"
+			results[i].LinkID = linkID
+			continue
+		}
+		saved[b.URL] = 0
+		pending = append(pending, i)
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	done := 0
+	sem := make(chan struct{}, max(opts.Concurrency, 1))
+	for _, i := range pending {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			b := bookmarks[i]
+			bookmark, err := linkding.CreateBookmark(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, linkding.CreateBookmarkOptions{
+				URL:         b.URL,
+				Title:       b.Title,
+				Description: b.Description,
+				TagNames:    b.Tags,
+				Unread:      b.ToRead,
+				Shared:      hasPrivate && !b.Private,
+			})
+			if err != nil {
+				results[i].Status = "failed"
+				results[i].Error = err.Error()
+			} else {
+				results[i].Status = "created"
+				results[i].LinkID = bookmark.ID
+			}
+
+			mu.Lock()
+			done++
+			fmt.Fprintf(os.Stderr, "[%d/%d] %s %s\n", done, len(pending), results[i].Status, b.URL)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	data := map[string]any{
+		"total": len(results),
+		"items": results,
+	}
+
+	return format.Output(data, opts.JSON, opts.JQ)
+}
+
+// ExportLinks writes all links, archived ones included, as a Netscape
+// bookmark file, JSON or CSV.
+func (a *App) ExportLinks(opts ExportLinksOptions) error {
+	bookmarks, err := linkding.EveryBookmark(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, 1)
+	if err != nil {
+		return fmt.Errorf("failed to list links: %w", err)
+	}
+
+	if opts.Output == "" {
+		return writeLinks(os.Stdout, opts.Format, bookmarks)
+	}
+
+	f, err := os.Create(opts.Output)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer f.Close()
+
+	if err := writeLinks(f, opts.Format, bookmarks); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Exported %d links to %s\n", len(bookmarks), opts.Output)
+	return nil
+}
+
+func writeLinks(w io.Writer, exportFormat string, bookmarks []api.Bookmark) error {
+	switch exportFormat {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(bookmarks)
+
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"id", "url", "title", "description", "notes", "tags", "unread", "shared", "is_archived", "date_added"})
+		for _, b := range bookmarks {
+			cw.Write([]string{
+				strconv.Itoa(b.ID),
+				b.URL,
+				bookmarkTitle(b),
+				bookmarkDescription(b),
+				b.Notes,
+				strings.Join(b.TagNames, " "),
+				strconv.FormatBool(b.Unread),
+				strconv.FormatBool(b.Shared),
+				strconv.FormatBool(b.IsArchived),
+				b.DateAdded.Format(time.RFC3339),
+			})
+		}
+		cw.Flush()
+		return cw.Error()
+
+	default:
+		items := make([]netscape.Bookmark, 0, len(bookmarks))
+		for _, b := range bookmarks {
+			items = append(items, netscape.Bookmark{
+				URL:         b.URL,
+				Title:       bookmarkTitle(b),
+				Description: bookmarkDescription(b),
+				Tags:        b.TagNames,
+				AddDate:     b.DateAdded,
+				Private:     !b.Shared,
+				ToRead:      b.Unread,
+			})
+		}
+		return netscape.Write(w, items)
+	}
+}
+
+// bookmarkTitle returns the title set on the bookmark, falling back to the
+// one linkding scraped from the website.
+func bookmarkTitle(b api.Bookmark) string {
+	if b.Title != "" {
+		return b.Title
+	}
+	return b.WebsiteTitle
+}
+
+func bookmarkDescription(b api.Bookmark) string {
+	if b.Description != "" {
+		return b.Description
+	}
+	return b.WebsiteDescription
+}
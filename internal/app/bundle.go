@@ -0,0 +1,80 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/goofansu/mlwcli/internal/format"
+	"github.com/goofansu/mlwcli/internal/linkding"
+)
+
+type ListBundlesOptions struct {
+	JSON string
+	JQ   string
+}
+
+type ShowBundleOptions struct {
+	Bundle string
+	JSON   string
+	JQ     string
+}
+
+type CreateBundleOptions struct {
+	Name         string
+	Search       string
+	AnyTags      string
+	AllTags      string
+	ExcludedTags string
+}
+
+func (a *App) ListBundles(opts ListBundlesOptions) error {
+	bundles, err := linkding.Bundles(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey)
+	if err != nil {
+		return fmt.Errorf("failed to list bundles: %w", err)
+	}
+
+	data := map[string]any{
+		"total": len(bundles),
+		"items": bundles,
+	}
+
+	return format.Output(data, opts.JSON, opts.JQ)
+}
+
+func (a *App) ShowBundle(opts ShowBundleOptions) error {
+	bundle, err := linkding.FindBundle(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, opts.Bundle)
+	if err != nil {
+		return fmt.Errorf("failed to get bundle: %w", err)
+	}
+
+	return format.Output(bundle, opts.JSON, opts.JQ)
+}
+
+func (a *App) CreateBundle(opts CreateBundleOptions) error {
+	bundle, err := linkding.CreateBundle(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, linkding.CreateBundleOptions{
+		Name:         opts.Name,
+		Search:       opts.Search,
+		AnyTags:      opts.AnyTags,
+		AllTags:      opts.AllTags,
+		ExcludedTags: opts.ExcludedTags,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+
+	fmt.Printf("✓ Bundle created successfully (ID: %d)\n", bundle.ID)
+	return nil
+}
+
+func (a *App) DeleteBundle(nameOrID string) error {
+	bundle, err := linkding.FindBundle(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, nameOrID)
+	if err != nil {
+		return fmt.Errorf("failed to delete bundle: %w", err)
+	}
+
+	if err := linkding.DeleteBundle(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, bundle.ID); err != nil {
+		return fmt.Errorf("failed to delete bundle: %w", err)
+	}
+
+	fmt.Printf("✓ Bundle %d deleted successfully\n", bundle.ID)
+	return nil
+}
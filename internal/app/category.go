@@ -0,0 +1,91 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goofansu/mlwcli/internal/format"
+	"github.com/goofansu/mlwcli/internal/miniflux"
+)
+
+type ListCategoriesOptions struct {
+	JSON string
+	JQ   string
+}
+
+func (a *App) ListCategories(opts ListCategoriesOptions) error {
+	categories, err := miniflux.Categories(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey)
+	if err != nil {
+		return fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	data := map[string]any{
+		"total": len(categories),
+		"items": categories,
+	}
+
+	return format.Output(data, opts.JSON, opts.JQ)
+}
+
+func (a *App) AddCategory(title string) error {
+	category, err := miniflux.CreateCategory(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, title)
+	if err != nil {
+		return fmt.Errorf("failed to create category: %w", err)
+	}
+
+	fmt.Printf("✓ Category created successfully (ID: %d)\n", category.ID)
+	return nil
+}
+
+func (a *App) RenameCategory(categoryID int64, title string) error {
+	_, err := miniflux.UpdateCategory(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, categoryID, title)
+	if err != nil {
+		return fmt.Errorf("failed to rename category: %w", err)
+	}
+
+	fmt.Printf("✓ Category %d renamed to %q\n", categoryID, title)
+	return nil
+}
+
+func (a *App) RemoveCategory(categoryID int64) error {
+	err := miniflux.DeleteCategory(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, categoryID)
+	if err != nil {
+		return fmt.Errorf("failed to remove category: %w", err)
+	}
+
+	fmt.Printf("✓ Category %d removed successfully\n", categoryID)
+	return nil
+}
+
+func (a *App) MarkCategoryAsRead(categoryID int64) error {
+	err := miniflux.MarkCategoryAsRead(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, categoryID)
+	if err != nil {
+		return fmt.Errorf("failed to mark category as read: %w", err)
+	}
+
+	fmt.Printf("✓ Category %d marked as read\n", categoryID)
+	return nil
+}
+
+// resolveCategoryID returns the ID of the category with the given title,
+// matched case-insensitively, creating the category when it does not exist.
+func (a *App) resolveCategoryID(title string) (int64, error) {
+	categories, err := miniflux.Categories(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	for _, category := range categories {
+		if strings.EqualFold(category.Title, title) {
+			return category.ID, nil
+		}
+	}
+
+	category, err := miniflux.CreateCategory(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, title)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create category: %w", err)
+	}
+
+	fmt.Printf("✓ Category %q created (ID: %d)\n", category.Title, category.ID)
+	return category.ID, nil
+}
@@ -1,41 +1,144 @@
 package app
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 
 	"github.com/goofansu/mlwcli/internal/format"
 	"github.com/goofansu/mlwcli/internal/miniflux"
+	api "miniflux.app/v2/client"
 )
 
 type AddFeedOptions struct {
 	URL        string
 	CategoryID int64
+	Category   string
+	Discover   bool
 }
 
-type ListFeedsOptions struct {
+type DiscoverFeedsOptions struct {
+	URL  string
 	JSON string
 	JQ   string
 }
 
+type ListFeedsOptions struct {
+	WithCounts bool
+	JSON       string
+	JQ         string
+}
+
+type FeedCountersOptions struct {
+	ByCategory bool
+	JSON       string
+	JQ         string
+}
+
+type feedWithCounts struct {
+	*api.Feed
+	Unread int `json:"unread"`
+	Read   int `json:"read"`
+}
+
+type feedCounter struct {
+	FeedID     int64  `json:"feed_id"`
+	Title      string `json:"title"`
+	CategoryID int64  `json:"category_id"`
+	Category   string `json:"category"`
+	Unread     int    `json:"unread"`
+	Read       int    `json:"read"`
+}
+
+type categoryCounter struct {
+	CategoryID int64  `json:"category_id"`
+	Category   string `json:"category"`
+	Feeds      int    `json:"feeds"`
+	Unread     int    `json:"unread"`
+	Read       int    `json:"read"`
+}
+
+type ShowFeedOptions struct {
+	FeedID int64
+	JSON   string
+	JQ     string
+}
+
+type UpdateFeedOptions struct {
+	FeedID       int64
+	Title        *string
+	CategoryID   *int64
+	Category     string
+	Crawler      *bool
+	ScraperRules *string
+	RewriteRules *string
+	UserAgent    *string
+	Disabled     *bool
+}
+
+type MarkEntriesReadOptions struct {
+	FeedID     int64
+	CategoryID int64
+	Before     string
+	Search     string
+	All        bool
+	DryRun     bool
+}
+
+type ShowEntryOptions struct {
+	EntryID int64
+	Format  string
+}
+
 type EntriesOptions struct {
-	FeedID  int64
-	Search  string
-	Limit   int
-	Status  string
-	Starred string
-	Offset  int
-	JSON    string
-	JQ      string
+	FeedID         int64
+	CategoryID     int64
+	Search         string
+	Limit          int
+	Status         string
+	Starred        string
+	Offset         int
+	Order          string
+	Direction      string
+	After          string
+	Before         string
+	PublishedAfter string
+	ChangedAfter   string
+	All            bool
+	Max            int
+	Concurrency    int
+	JSON           string
+	JQ             string
 }
 
 func (a *App) AddFeed(opts AddFeedOptions) error {
+	if opts.CategoryID != 0 && opts.Category != "" {
+		return fmt.Errorf("--category-id and --category cannot be used together")
+	}
+
 	categoryID := opts.CategoryID
+	if opts.Category != "" {
+		id, err := a.resolveCategoryID(opts.Category)
+		if err != nil {
+			return err
+		}
+		categoryID = id
+	}
 	if categoryID == 0 {
 		categoryID = 1
 	}
 
+	feedURL := opts.URL
+	if opts.Discover {
+		url, err := a.discoverFeedURL(opts.URL)
+		if err != nil {
+			return err
+		}
+		feedURL = url
+	}
+
 	feedID, err := miniflux.CreateFeed(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, miniflux.CreateFeedOptions{
-		FeedURL:    opts.URL,
+		FeedURL:    feedURL,
 		CategoryID: categoryID,
 	})
 	if err != nil {
@@ -46,29 +149,238 @@ func (a *App) AddFeed(opts AddFeedOptions) error {
 	return nil
 }
 
+func (a *App) DiscoverFeeds(opts DiscoverFeedsOptions) error {
+	subscriptions, err := miniflux.Discover(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, opts.URL)
+	if err != nil {
+		return fmt.Errorf("failed to discover feeds: %w", err)
+	}
+
+	data := map[string]any{
+		"total": len(subscriptions),
+		"items": subscriptions,
+	}
+
+	return format.Output(data, opts.JSON, opts.JQ)
+}
+
+// discoverFeedURL returns the feed URL found at siteURL, prompting the user
+// to pick one when the site advertises several feeds.
+func (a *App) discoverFeedURL(siteURL string) (string, error) {
+	subscriptions, err := miniflux.Discover(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, siteURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to discover feeds: %w", err)
+	}
+
+	switch len(subscriptions) {
+	case 0:
+		return "", fmt.Errorf("no feeds found at %s", siteURL)
+	case 1:
+		return subscriptions[0].URL, nil
+	default:
+		return promptSubscription(subscriptions)
+	}
+}
+
 func (a *App) ListFeeds(opts ListFeedsOptions) error {
 	feeds, err := miniflux.Feeds(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey)
 	if err != nil {
 		return fmt.Errorf("failed to list feeds: %w", err)
 	}
 
+	var items any = feeds
+	if opts.WithCounts {
+		counters, err := miniflux.Counters(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey)
+		if err != nil {
+			return fmt.Errorf("failed to fetch feed counters: %w", err)
+		}
+
+		withCounts := make([]feedWithCounts, 0, len(feeds))
+		for _, feed := range feeds {
+			withCounts = append(withCounts, feedWithCounts{
+				Feed:   feed,
+				Unread: counters.UnreadCounters[feed.ID],
+				Read:   counters.ReadCounters[feed.ID],
+			})
+		}
+		items = withCounts
+	}
+
 	data := map[string]any{
 		"total": len(feeds),
-		"items": feeds,
+		"items": items,
 	}
 
 	return format.Output(data, opts.JSON, opts.JQ)
 }
 
-func (a *App) ListEntries(opts EntriesOptions) error {
-	result, err := miniflux.Entries(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, miniflux.EntriesOptions{
-		FeedID:  opts.FeedID,
-		Search:  opts.Search,
-		Limit:   opts.Limit,
-		Offset:  opts.Offset,
-		Status:  opts.Status,
-		Starred: opts.Starred,
+func (a *App) FeedCounters(opts FeedCountersOptions) error {
+	feeds, err := miniflux.Feeds(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey)
+	if err != nil {
+		return fmt.Errorf("failed to list feeds: %w", err)
+	}
+
+	counters, err := miniflux.Counters(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey)
+	if err != nil {
+		return fmt.Errorf("failed to fetch feed counters: %w", err)
+	}
+
+	feedCounters := make([]feedCounter, 0, len(feeds))
+	for _, feed := range feeds {
+		c := feedCounter{
+			FeedID: feed.ID,
+			Title:  feed.Title,
+			Unread: counters.UnreadCounters[feed.ID],
+			Read:   counters.ReadCounters[feed.ID],
+		}
+		if feed.Category != nil {
+			c.CategoryID = feed.Category.ID
+			c.Category = feed.Category.Title
+		}
+		feedCounters = append(feedCounters, c)
+	}
+
+	var items any
+	var total int
+	if opts.ByCategory {
+		index := map[int64]int{}
+		categoryCounters := []categoryCounter{}
+		for _, c := range feedCounters {
+			i, ok := index[c.CategoryID]
+			if !ok {
+				i = len(categoryCounters)
+				index[c.CategoryID] = i
+				categoryCounters = append(categoryCounters, categoryCounter{
+					CategoryID: c.CategoryID,
+					Category:   c.Category,
+				})
+			}
+			categoryCounters[i].Feeds++
+			categoryCounters[i].Unread += c.Unread
+			categoryCounters[i].Read += c.Read
+		}
+		slices.SortStableFunc(categoryCounters, func(x, y categoryCounter) int {
+			return cmp.Compare(y.Unread, x.Unread)
+		})
+		items, total = categoryCounters, len(categoryCounters)
+	} else {
+		slices.SortStableFunc(feedCounters, func(x, y feedCounter) int {
+			return cmp.Compare(y.Unread, x.Unread)
+		})
+		items, total = feedCounters, len(feedCounters)
+	}
+
+	data := map[string]any{
+		"total": total,
+		"items": items,
+	}
+
+	return format.Output(data, opts.JSON, opts.JQ)
+}
+
+func (a *App) ShowFeed(opts ShowFeedOptions) error {
+	feed, err := miniflux.Feed(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, opts.FeedID)
+	if err != nil {
+		return fmt.Errorf("failed to get feed: %w", err)
+	}
+
+	return format.Output(feed, opts.JSON, opts.JQ)
+}
+
+func (a *App) UpdateFeed(opts UpdateFeedOptions) error {
+	if opts.CategoryID != nil && opts.Category != "" {
+		return fmt.Errorf("--category-id and --category cannot be used together")
+	}
+
+	categoryID := opts.CategoryID
+	if opts.Category != "" {
+		id, err := a.resolveCategoryID(opts.Category)
+		if err != nil {
+			return err
+		}
+		categoryID = &id
+	}
+
+	_, err := miniflux.UpdateFeed(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, opts.FeedID, miniflux.UpdateFeedOptions{
+		Title:        opts.Title,
+		CategoryID:   categoryID,
+		Crawler:      opts.Crawler,
+		ScraperRules: opts.ScraperRules,
+		RewriteRules: opts.RewriteRules,
+		UserAgent:    opts.UserAgent,
+		Disabled:     opts.Disabled,
 	})
+	if err != nil {
+		return fmt.Errorf("failed to update feed: %w", err)
+	}
+
+	fmt.Printf("✓ Feed %d updated successfully\n", opts.FeedID)
+	return nil
+}
+
+func (a *App) RefreshFeed(feedID int64) error {
+	if err := miniflux.RefreshFeed(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, feedID); err != nil {
+		return fmt.Errorf("failed to refresh feed: %w", err)
+	}
+
+	fmt.Printf("✓ Feed %d refresh requested\n", feedID)
+	return nil
+}
+
+func (a *App) RefreshAllFeeds() error {
+	if err := miniflux.RefreshAllFeeds(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey); err != nil {
+		return fmt.Errorf("failed to refresh feeds: %w", err)
+	}
+
+	fmt.Printf("✓ Refresh requested for all feeds\n")
+	return nil
+}
+
+func (a *App) RemoveFeed(feedID int64) error {
+	if err := miniflux.DeleteFeed(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, feedID); err != nil {
+		return fmt.Errorf("failed to remove feed: %w", err)
+	}
+
+	fmt.Printf("✓ Feed %d removed successfully\n", feedID)
+	return nil
+}
+
+func (a *App) ListEntries(opts EntriesOptions) error {
+	entriesOpts := miniflux.EntriesOptions{
+		FeedID:     opts.FeedID,
+		CategoryID: opts.CategoryID,
+		Search:     opts.Search,
+		Limit:      opts.Limit,
+		Offset:     opts.Offset,
+		Status:     opts.Status,
+		Starred:    opts.Starred,
+		Order:      opts.Order,
+		Direction:  opts.Direction,
+	}
+
+	var err error
+	if entriesOpts.PublishedBefore, err = parseUnixTime(opts.Before); err != nil {
+		return fmt.Errorf("invalid --before: %w", err)
+	}
+	publishedAfter, flag := opts.PublishedAfter, "--published-after"
+	if opts.After != "" {
+		if opts.PublishedAfter != "" {
+			return fmt.Errorf("--after cannot be combined with --published-after")
+		}
+		publishedAfter, flag = opts.After, "--after"
+	}
+	if entriesOpts.PublishedAfter, err = parseUnixTime(publishedAfter); err != nil {
+		return fmt.Errorf("invalid %s: %w", flag, err)
+	}
+	if entriesOpts.ChangedAfter, err = parseUnixTime(opts.ChangedAfter); err != nil {
+		return fmt.Errorf("invalid --changed-after: %w", err)
+	}
+
+	var result *api.EntryResultSet
+	if opts.All {
+		result, err = miniflux.AllEntries(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, entriesOpts, opts.Max, opts.Concurrency)
+	} else {
+		result, err = miniflux.Entries(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, entriesOpts)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to list entries: %w", err)
 	}
@@ -88,3 +400,169 @@ func (a *App) SaveEntry(entryID int64) error {
 	fmt.Printf("Entry %d saved successfully\n", entryID)
 	return nil
 }
+
+func (a *App) UpdateEntriesStatus(entryIDs []int64, status string) error {
+	err := miniflux.UpdateEntries(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, entryIDs, status)
+	if err != nil {
+		return fmt.Errorf("failed to mark entries as %s: %w", status, err)
+	}
+
+	data := map[string]any{
+		"status":  status,
+		"updated": entryIDs,
+	}
+	return format.Output(data, "", "")
+}
+
+func (a *App) MarkEntriesRead(opts MarkEntriesReadOptions) error {
+	hasFilter := opts.FeedID != 0 || opts.CategoryID != 0 || opts.Before != "" || opts.Search != ""
+	if opts.All && hasFilter {
+		return fmt.Errorf("--all cannot be combined with other filters")
+	}
+	if !opts.All && !hasFilter {
+		return fmt.Errorf("one of --feed-id, --category-id, --before, --search or --all is required")
+	}
+
+	entriesOpts := miniflux.EntriesOptions{
+		FeedID:     opts.FeedID,
+		CategoryID: opts.CategoryID,
+		Search:     opts.Search,
+		Status:     "unread",
+	}
+	before, err := parseUnixTime(opts.Before)
+	if err != nil {
+		return fmt.Errorf("invalid --before: %w", err)
+	}
+	entriesOpts.PublishedBefore = before
+
+	count, err := a.countEntries(entriesOpts)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]any{
+		"status":  "read",
+		"count":   count,
+		"dry_run": opts.DryRun,
+	}
+	if opts.DryRun || count == 0 {
+		return format.Output(data, "", "")
+	}
+
+	switch {
+	case opts.All:
+		err = miniflux.MarkAllAsRead(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey)
+	case opts.FeedID != 0 && opts.CategoryID == 0 && opts.Before == "" && opts.Search == "":
+		err = miniflux.MarkFeedAsRead(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, opts.FeedID)
+	case opts.CategoryID != 0 && opts.FeedID == 0 && opts.Before == "" && opts.Search == "":
+		err = miniflux.MarkCategoryAsRead(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, opts.CategoryID)
+	default:
+		err = a.markMatchingEntriesRead(entriesOpts)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to mark entries as read: %w", err)
+	}
+
+	return format.Output(data, "", "")
+}
+
+func (a *App) countEntries(opts miniflux.EntriesOptions) (int, error) {
+	opts.Limit = 1
+	result, err := miniflux.Entries(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, opts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count entries: %w", err)
+	}
+	return result.Total, nil
+}
+
+// markMatchingEntriesRead collects the IDs of all entries matching opts
+// before marking them as read, so that paging is not affected by the
+// entries leaving the unread set.
+func (a *App) markMatchingEntriesRead(opts miniflux.EntriesOptions) error {
+	const pageSize = 250
+
+	var entryIDs []int64
+	opts.Limit = pageSize
+	for {
+		result, err := miniflux.Entries(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, opts)
+		if err != nil {
+			return err
+		}
+		for _, entry := range result.Entries {
+			entryIDs = append(entryIDs, entry.ID)
+		}
+		if len(result.Entries) < pageSize {
+			break
+		}
+		opts.Offset += pageSize
+	}
+
+	for start := 0; start < len(entryIDs); start += pageSize {
+		end := min(start+pageSize, len(entryIDs))
+		if err := miniflux.UpdateEntries(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, entryIDs[start:end], "read"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *App) StarEntries(entryIDs []int64, starred bool) error {
+	updated := []int64{}
+	unchanged := []int64{}
+
+	for _, entryID := range entryIDs {
+		entry, err := miniflux.Entry(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, entryID)
+		if err != nil {
+			return fmt.Errorf("failed to get entry %d: %w", entryID, err)
+		}
+
+		if entry.Starred == starred {
+			unchanged = append(unchanged, entryID)
+			continue
+		}
+
+		if err := miniflux.ToggleStarred(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, entryID); err != nil {
+			return fmt.Errorf("failed to toggle starred for entry %d: %w", entryID, err)
+		}
+		updated = append(updated, entryID)
+	}
+
+	data := map[string]any{
+		"starred":   starred,
+		"updated":   updated,
+		"unchanged": unchanged,
+	}
+	return format.Output(data, "", "")
+}
+
+func (a *App) ShowEntry(opts ShowEntryOptions) error {
+	entry, err := miniflux.Entry(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, opts.EntryID)
+	if err != nil {
+		return fmt.Errorf("failed to get entry: %w", err)
+	}
+
+	if opts.Format == "html" {
+		fmt.Println(entry.Content)
+		return nil
+	}
+
+	meta := []string{}
+	if entry.Feed != nil && entry.Feed.Title != "" {
+		meta = append(meta, entry.Feed.Title)
+	}
+	if entry.Author != "" {
+		meta = append(meta, entry.Author)
+	}
+	if !entry.Date.IsZero() {
+		meta = append(meta, entry.Date.Format("2006-01-02 15:04"))
+	}
+
+	text, err := renderArticle(entry.Title, entry.URL, meta, entry.Content, opts.Format == "markdown")
+	if err != nil {
+		return fmt.Errorf("failed to render entry: %w", err)
+	}
+
+	fmt.Print(text)
+	return nil
+}
@@ -0,0 +1,202 @@
+package app
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goofansu/mlwcli/internal/format"
+	"github.com/goofansu/mlwcli/internal/miniflux"
+	api "miniflux.app/v2/client"
+)
+
+const healthConcurrency = 8
+
+const (
+	issueError    = "error"
+	issueStale    = "stale"
+	issueDisabled = "disabled"
+	issueUnknown  = "unknown"
+)
+
+var issueSeverity = map[string]int{
+	issueError:    4,
+	issueStale:    3,
+	issueDisabled: 2,
+	issueUnknown:  1,
+}
+
+type FeedHealthOptions struct {
+	StaleDays int
+	Fix       bool
+	ExitCode  bool
+	JSON      string
+	JQ        string
+}
+
+type feedHealth struct {
+	ID                  int64      `json:"id"`
+	Title               string     `json:"title"`
+	FeedURL             string     `json:"feed_url"`
+	Category            string     `json:"category"`
+	Severity            string     `json:"severity"`
+	Issues              []string   `json:"issues"`
+	ParsingErrorCount   int        `json:"parsing_error_count"`
+	ParsingErrorMessage string     `json:"parsing_error_message,omitempty"`
+	CheckedAt           time.Time  `json:"checked_at"`
+	LastPublishedAt     *time.Time `json:"last_published_at"`
+	DaysSincePublished  *int       `json:"days_since_published"`
+	PublishedError      string     `json:"published_error,omitempty"`
+	Refreshed           bool       `json:"refreshed,omitempty"`
+	RefreshError        string     `json:"refresh_error,omitempty"`
+}
+
+func (a *App) FeedHealth(opts FeedHealthOptions) error {
+	feeds, err := miniflux.Feeds(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey)
+	if err != nil {
+		return fmt.Errorf("failed to list feeds: %w", err)
+	}
+
+	refreshed := map[int64]bool{}
+	refreshErrors := map[int64]string{}
+	if opts.Fix {
+		for _, feed := range feeds {
+			if feed.ParsingErrorCount == 0 {
+				continue
+			}
+			if err := miniflux.RefreshFeed(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, feed.ID); err != nil {
+				refreshErrors[feed.ID] = err.Error()
+				continue
+			}
+			refreshed[feed.ID] = true
+		}
+
+		if len(refreshed) > 0 {
+			feeds, err = miniflux.Feeds(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey)
+			if err != nil {
+				return fmt.Errorf("failed to list feeds: %w", err)
+			}
+		}
+	}
+
+	lastPublished, publishedErrors := a.lastPublished(feeds)
+
+	now := time.Now()
+	report := []feedHealth{}
+	for _, feed := range feeds {
+		h := feedHealth{
+			ID:                  feed.ID,
+			Title:               feed.Title,
+			FeedURL:             feed.FeedURL,
+			ParsingErrorCount:   feed.ParsingErrorCount,
+			ParsingErrorMessage: feed.ParsingErrorMsg,
+			CheckedAt:           feed.CheckedAt,
+			PublishedError:      publishedErrors[feed.ID],
+			Refreshed:           refreshed[feed.ID],
+			RefreshError:        refreshErrors[feed.ID],
+		}
+		if feed.Category != nil {
+			h.Category = feed.Category.Title
+		}
+
+		if published, ok := lastPublished[feed.ID]; ok {
+			days := int(now.Sub(published).Hours() / 24)
+			h.LastPublishedAt = &published
+			h.DaysSincePublished = &days
+		}
+
+		if feed.ParsingErrorCount > 0 {
+			h.Issues = append(h.Issues, issueError)
+		}
+		if opts.StaleDays > 0 && h.PublishedError == "" && (h.DaysSincePublished == nil || *h.DaysSincePublished >= opts.StaleDays) {
+			h.Issues = append(h.Issues, issueStale)
+		}
+		if feed.Disabled {
+			h.Issues = append(h.Issues, issueDisabled)
+		}
+		if h.PublishedError != "" {
+			h.Issues = append(h.Issues, issueUnknown)
+		}
+		if len(h.Issues) == 0 {
+			continue
+		}
+
+		h.Severity = h.Issues[0]
+		report = append(report, h)
+	}
+
+	slices.SortFunc(report, func(x, y feedHealth) int {
+		return cmp.Or(
+			cmp.Compare(issueSeverity[y.Severity], issueSeverity[x.Severity]),
+			cmp.Compare(y.ParsingErrorCount, x.ParsingErrorCount),
+			cmp.Compare(daysOrMax(y.DaysSincePublished), daysOrMax(x.DaysSincePublished)),
+			cmp.Compare(strings.ToLower(x.Title), strings.ToLower(y.Title)),
+		)
+	})
+
+	data := map[string]any{
+		"total": len(report),
+		"items": report,
+	}
+
+	if err := format.Output(data, opts.JSON, opts.JQ); err != nil {
+		return err
+	}
+
+	if opts.ExitCode && len(report) > 0 {
+		return fmt.Errorf("%d unhealthy feeds found", len(report))
+	}
+
+	return nil
+}
+
+// lastPublished returns the publication date of the most recent entry of
+// each feed. Feeds without entries are left out; feeds whose entries could
+// not be listed are returned with the error instead.
+func (a *App) lastPublished(feeds api.Feeds) (map[int64]time.Time, map[int64]string) {
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	result := map[int64]time.Time{}
+	errs := map[int64]string{}
+	sem := make(chan struct{}, healthConcurrency)
+
+	for _, feed := range feeds {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(feedID int64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			entries, err := miniflux.Entries(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, miniflux.EntriesOptions{
+				FeedID: feedID,
+				Limit:  1,
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[feedID] = fmt.Sprintf("failed to list entries: %v", err)
+				return
+			}
+			if len(entries.Entries) > 0 {
+				result[feedID] = entries.Entries[0].Date
+			}
+		}(feed.ID)
+	}
+	wg.Wait()
+
+	return result, errs
+}
+
+func daysOrMax(days *int) int {
+	if days == nil {
+		return math.MaxInt
+	}
+	return *days
+}
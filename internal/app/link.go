@@ -2,24 +2,69 @@ package app
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/goofansu/mlwcli/internal/format"
 	"github.com/goofansu/mlwcli/internal/linkding"
+	api "github.com/piero-vic/go-linkding"
 )
 
 type AddLinkOptions struct {
 	URL   string
 	Notes string
 	Tags  string
+	Merge bool
 }
 
 type ListLinksOptions struct {
-	Query  string
-	Limit  int
-	Offset int
-	JSON   string
-	JQ     string
+	Query       string
+	Tags        []string
+	Archived    bool
+	Unread      bool
+	Shared      bool
+	AddedAfter  string
+	Sort        string
+	Bundle      string
+	Limit       int
+	Offset      int
+	All         bool
+	Max         int
+	Concurrency int
+	JSON        string
+	JQ          string
+}
+
+type ShowLinkOptions struct {
+	ID   int
+	JSON string
+	JQ   string
+}
+
+type UpdateLinkOptions struct {
+	ID          int
+	Title       *string
+	Description *string
+	Notes       *string
+	Tags        *string
+	AddTags     string
+	RemoveTags  string
+	Unread      *bool
+	Shared      *bool
+}
+
+type ListLinkAssetsOptions struct {
+	ID   int
+	JSON string
+	JQ   string
+}
+
+type DownloadLinkAssetOptions struct {
+	LinkID  int
+	AssetID int
+	Output  string
 }
 
 func (a *App) AddLink(opts AddLinkOptions) error {
@@ -28,7 +73,37 @@ func (a *App) AddLink(opts AddLinkOptions) error {
 		tagNames = strings.Split(opts.Tags, " ")
 	}
 
-	_, err := linkding.CreateBookmark(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, linkding.CreateBookmarkOptions{
+	check, err := linkding.CheckBookmark(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, opts.URL)
+	if err != nil {
+		return fmt.Errorf("failed to check link: %w", err)
+	}
+
+	if check.Metadata.Title != "" {
+		fmt.Printf("Title: %s\n", check.Metadata.Title)
+	}
+	if check.Metadata.Description != "" {
+		fmt.Printf("Description: %s\n", check.Metadata.Description)
+	}
+
+	if existing := check.Bookmark; existing != nil {
+		if !opts.Merge {
+			return fmt.Errorf("link already exists (ID: %d), use --merge to add tags and notes to it", existing.ID)
+		}
+
+		notes := mergeNotes(existing.Notes, opts.Notes)
+		_, err := linkding.UpdateBookmark(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, existing.ID, linkding.UpdateBookmarkOptions{
+			Notes:   &notes,
+			AddTags: tagNames,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to merge link: %w", err)
+		}
+
+		fmt.Printf("✓ Link merged into existing link (ID: %d)\n", existing.ID)
+		return nil
+	}
+
+	bookmark, err := linkding.CreateBookmark(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, linkding.CreateBookmarkOptions{
 		URL:      opts.URL,
 		Notes:    opts.Notes,
 		TagNames: tagNames,
@@ -37,16 +112,71 @@ func (a *App) AddLink(opts AddLinkOptions) error {
 		return fmt.Errorf("failed to create link: %w", err)
 	}
 
-	fmt.Printf("✓ Link created successfully\n")
+	fmt.Printf("✓ Link created successfully (ID: %d)\n", bookmark.ID)
 	return nil
 }
 
+// linkQuery combines a search query with tags using linkding's #tag syntax.
+func linkQuery(query string, tags []string) string {
+	terms := []string{}
+	for _, tag := range tags {
+		terms = append(terms, "#"+strings.TrimPrefix(tag, "#"))
+	}
+	if query != "" {
+		terms = append(terms, query)
+	}
+	return strings.Join(terms, " ")
+}
+
+// mergeNotes appends notes to existing unless they are already part of it.
+func mergeNotes(existing, notes string) string {
+	if notes == "" || strings.Contains(existing, notes) {
+		return existing
+	}
+	if existing == "" {
+		return notes
+	}
+	return existing + "\n\n" + notes
+}
+
+var linkSortOrders = []string{"added_asc", "added_desc", "title_asc", "title_desc"}
+
 func (a *App) ListLinks(opts ListLinksOptions) error {
-	result, err := linkding.ListBookmarks(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, linkding.ListBookmarksOptions{
-		Query:  opts.Query,
-		Limit:  opts.Limit,
-		Offset: opts.Offset,
-	})
+	if opts.Sort != "" && !slices.Contains(linkSortOrders, opts.Sort) {
+		return fmt.Errorf("invalid sort %q (expected one of %s)", opts.Sort, strings.Join(linkSortOrders, ", "))
+	}
+
+	listOpts := linkding.ListBookmarksOptions{
+		Query:    linkQuery(opts.Query, opts.Tags),
+		Limit:    opts.Limit,
+		Offset:   opts.Offset,
+		Archived: opts.Archived,
+		Unread:   opts.Unread,
+		Shared:   opts.Shared,
+		Sort:     opts.Sort,
+	}
+	if opts.Bundle != "" {
+		bundle, err := linkding.FindBundle(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, opts.Bundle)
+		if err != nil {
+			return fmt.Errorf("failed to get bundle: %w", err)
+		}
+		listOpts.Bundle = bundle
+	}
+	if opts.AddedAfter != "" {
+		addedAfter, err := parseTime(opts.AddedAfter)
+		if err != nil {
+			return err
+		}
+		listOpts.AddedSince = addedAfter
+	}
+
+	var result *api.ListBookmarksResponse
+	var err error
+	if opts.All {
+		result, err = linkding.AllBookmarks(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, listOpts, opts.Max, opts.Concurrency)
+	} else {
+		result, err = linkding.ListBookmarks(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, listOpts)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to list links: %w", err)
 	}
@@ -58,3 +188,115 @@ func (a *App) ListLinks(opts ListLinksOptions) error {
 
 	return format.Output(data, opts.JSON, opts.JQ)
 }
+
+func (a *App) ShowLink(opts ShowLinkOptions) error {
+	bookmark, err := linkding.GetBookmark(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, opts.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get link: %w", err)
+	}
+
+	return format.Output(bookmark, opts.JSON, opts.JQ)
+}
+
+func (a *App) UpdateLink(opts UpdateLinkOptions) error {
+	updateOpts := linkding.UpdateBookmarkOptions{
+		Title:       opts.Title,
+		Description: opts.Description,
+		Notes:       opts.Notes,
+		AddTags:     strings.Fields(opts.AddTags),
+		RemoveTags:  strings.Fields(opts.RemoveTags),
+		Unread:      opts.Unread,
+		Shared:      opts.Shared,
+	}
+	if opts.Tags != nil {
+		updateOpts.TagNames = strings.Fields(*opts.Tags)
+	}
+
+	_, err := linkding.UpdateBookmark(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, opts.ID, updateOpts)
+	if err != nil {
+		return fmt.Errorf("failed to update link: %w", err)
+	}
+
+	fmt.Printf("✓ Link %d updated successfully\n", opts.ID)
+	return nil
+}
+
+func (a *App) DeleteLink(id int) error {
+	if err := linkding.DeleteBookmark(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, id); err != nil {
+		return fmt.Errorf("failed to delete link: %w", err)
+	}
+
+	fmt.Printf("✓ Link %d deleted successfully\n", id)
+	return nil
+}
+
+func (a *App) ArchiveLink(id int) error {
+	if err := linkding.ArchiveBookmark(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, id); err != nil {
+		return fmt.Errorf("failed to archive link: %w", err)
+	}
+
+	fmt.Printf("✓ Link %d archived successfully\n", id)
+	return nil
+}
+
+func (a *App) UnarchiveLink(id int) error {
+	if err := linkding.UnarchiveBookmark(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, id); err != nil {
+		return fmt.Errorf("failed to unarchive link: %w", err)
+	}
+
+	fmt.Printf("✓ Link %d unarchived successfully\n", id)
+	return nil
+}
+
+func (a *App) ListLinkAssets(opts ListLinkAssetsOptions) error {
+	result, err := linkding.BookmarkAssets(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, opts.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list assets: %w", err)
+	}
+
+	data := map[string]any{
+		"total": result.Count,
+		"items": result.Results,
+	}
+
+	return format.Output(data, opts.JSON, opts.JQ)
+}
+
+func (a *App) DownloadLinkAsset(opts DownloadLinkAssetOptions) error {
+	if opts.Output == "" {
+		if err := linkding.DownloadBookmarkAsset(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, opts.LinkID, opts.AssetID, os.Stdout); err != nil {
+			return fmt.Errorf("failed to download asset: %w", err)
+		}
+		return nil
+	}
+
+	f, err := os.Create(opts.Output)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer f.Close()
+
+	if err := linkding.DownloadBookmarkAsset(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, opts.LinkID, opts.AssetID, f); err != nil {
+		os.Remove(opts.Output)
+		return fmt.Errorf("failed to download asset: %w", err)
+	}
+
+	fmt.Printf("✓ Asset %d downloaded to %s\n", opts.AssetID, opts.Output)
+	return nil
+}
+
+func (a *App) UploadLinkAsset(linkID int, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	asset, err := linkding.UploadBookmarkAsset(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, linkID, filepath.Base(file), f)
+	if err != nil {
+		return fmt.Errorf("failed to upload asset: %w", err)
+	}
+
+	fmt.Printf("✓ Asset uploaded successfully (ID: %d)\n", asset.ID)
+	return nil
+}
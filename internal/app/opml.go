@@ -0,0 +1,163 @@
+package app
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/goofansu/mlwcli/internal/format"
+	"github.com/goofansu/mlwcli/internal/miniflux"
+	"github.com/goofansu/mlwcli/internal/opml"
+)
+
+type ImportFeedsOptions struct {
+	File string
+	JSON string
+	JQ   string
+}
+
+type ExportFeedsOptions struct {
+	Output string
+}
+
+type importResult struct {
+	FeedURL  string `json:"feed_url"`
+	Title    string `json:"title"`
+	Category string `json:"category"`
+	Status   string `json:"status"`
+	FeedID   int64  `json:"feed_id,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (a *App) ImportFeeds(opts ImportFeedsOptions) error {
+	f, err := os.Open(opts.File)
+	if err != nil {
+		return fmt.Errorf("failed to open OPML file: %w", err)
+	}
+	defer f.Close()
+
+	subscriptions, err := opml.Parse(f)
+	if err != nil {
+		return err
+	}
+
+	feeds, err := miniflux.Feeds(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey)
+	if err != nil {
+		return fmt.Errorf("failed to list feeds: %w", err)
+	}
+	subscribed := map[string]int64{}
+	for _, feed := range feeds {
+		subscribed[feed.FeedURL] = feed.ID
+	}
+
+	categories, err := miniflux.Categories(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey)
+	if err != nil {
+		return fmt.Errorf("failed to list categories: %w", err)
+	}
+	categoryIDs := map[string]int64{}
+	for _, category := range categories {
+		categoryIDs[strings.ToLower(category.Title)] = category.ID
+	}
+
+	results := make([]importResult, 0, len(subscriptions))
+	for _, s := range subscriptions {
+		result := importResult{
+			FeedURL:  s.FeedURL,
+			Title:    s.Title,
+			Category: s.Category,
+		}
+
+		if feedID, ok := subscribed[s.FeedURL]; ok {
+			result.Status = "skipped"
+			result.FeedID = feedID
+			results = append(results, result)
+			continue
+		}
+
+		categoryID := int64(1)
+		if s.Category != "" {
+			id, ok := categoryIDs[strings.ToLower(s.Category)]
+			if !ok {
+				category, err := miniflux.CreateCategory(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, s.Category)
+				if err != nil {
+					result.Status = "failed"
+					result.Error = fmt.Sprintf("failed to create category: %v", err)
+					results = append(results, result)
+					continue
+				}
+				id = category.ID
+				categoryIDs[strings.ToLower(s.Category)] = id
+			}
+			categoryID = id
+		}
+
+		feedID, err := miniflux.CreateFeed(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey, miniflux.CreateFeedOptions{
+			FeedURL:    s.FeedURL,
+			CategoryID: categoryID,
+		})
+		if err != nil {
+			result.Status = "failed"
+			result.Error = err.Error()
+			results = append(results, result)
+			continue
+		}
+
+		subscribed[s.FeedURL] = feedID
+		result.Status = "created"
+		result.FeedID = feedID
+		results = append(results, result)
+	}
+
+	data := map[string]any{
+		"total": len(results),
+		"items": results,
+	}
+
+	return format.Output(data, opts.JSON, opts.JQ)
+}
+
+func (a *App) ExportFeeds(opts ExportFeedsOptions) error {
+	feeds, err := miniflux.Feeds(a.Config.Miniflux.Endpoint, a.Config.Miniflux.APIKey)
+	if err != nil {
+		return fmt.Errorf("failed to list feeds: %w", err)
+	}
+
+	subscriptions := make([]opml.Subscription, 0, len(feeds))
+	for _, feed := range feeds {
+		category := ""
+		if feed.Category != nil {
+			category = feed.Category.Title
+		}
+		subscriptions = append(subscriptions, opml.Subscription{
+			Title:    feed.Title,
+			FeedURL:  feed.FeedURL,
+			SiteURL:  feed.SiteURL,
+			Category: category,
+		})
+	}
+	slices.SortStableFunc(subscriptions, func(x, y opml.Subscription) int {
+		return cmp.Or(
+			cmp.Compare(strings.ToLower(x.Category), strings.ToLower(y.Category)),
+			cmp.Compare(strings.ToLower(x.Title), strings.ToLower(y.Title)),
+		)
+	})
+
+	if opts.Output == "" {
+		return opml.Write(os.Stdout, "Miniflux feeds", subscriptions)
+	}
+
+	f, err := os.Create(opts.Output)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer f.Close()
+
+	if err := opml.Write(f, "Miniflux feeds", subscriptions); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Exported %d feeds to %s\n", len(subscriptions), opts.Output)
+	return nil
+}
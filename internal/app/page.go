@@ -2,29 +2,98 @@ package app
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
 
 	"github.com/goofansu/mlwcli/internal/format"
 	"github.com/goofansu/mlwcli/internal/wallabag"
 )
 
 type AddPageOptions struct {
-	URL     string
-	Tags    string
-	Archive bool
+	URL         string
+	Title       string
+	Tags        string
+	Starred     bool
+	Archive     bool
+	ContentFile string
+	Language    string
+	PublishedAt string
+	Authors     string
 }
 
 type ListPagesOptions struct {
+	Archive     int
+	Starred     int
+	Page        int
+	PerPage     int
+	Tags        string
+	Domain      string
+	All         bool
+	Max         int
+	Concurrency int
+	JSON        string
+	JQ          string
+}
+
+type PagesExistOptions struct {
+	URLs []string
+	JSON string
+	JQ   string
+}
+
+type pageExists struct {
+	URL    string `json:"url"`
+	Exists bool   `json:"exists"`
+	ID     *int   `json:"id"`
+}
+
+type ShowPageOptions struct {
+	ID   int
+	JSON string
+	JQ   string
+}
+
+type ReadPageOptions struct {
+	ID       int
+	Markdown bool
+}
+
+type ExportPagesOptions struct {
+	ID      int
+	Format  string
+	Output  string
 	Archive int
 	Starred int
-	Page    int
-	PerPage int
 	Tags    string
 	Domain  string
-	JSON    string
-	JQ      string
 }
 
-func (a *App) AddPage(opts AddPageOptions) error {
+type ListPageTagsOptions struct {
+	JSON string
+	JQ   string
+}
+
+type pageTagWithCount struct {
+	ID    int    `json:"id"`
+	Label string `json:"label"`
+	Slug  string `json:"slug"`
+	Count int    `json:"count"`
+}
+
+type UpdatePageOptions struct {
+	ID             int
+	Title          *string
+	Tags           string
+	Starred        *bool
+	Archive        *bool
+	Language       *string
+	PreviewPicture *string
+}
+
+func (a *App) loadWallabag() {
 	wallabag.LoadConfig(
 		a.Config.Wallabag.Endpoint,
 		a.Config.Wallabag.ClientID,
@@ -32,23 +101,118 @@ func (a *App) AddPage(opts AddPageOptions) error {
 		a.Config.Wallabag.Username,
 		a.Config.Wallabag.Password,
 	)
+}
+
+func (a *App) AddPage(opts AddPageOptions) error {
+	a.loadWallabag()
+
+	createOpts := wallabag.CreateEntryOptions{
+		URL:      opts.URL,
+		Title:    opts.Title,
+		Tags:     opts.Tags,
+		Starred:  opts.Starred,
+		Archive:  opts.Archive,
+		Language: opts.Language,
+		Authors:  opts.Authors,
+	}
+	if opts.ContentFile != "" {
+		content, err := os.ReadFile(opts.ContentFile)
+		if err != nil {
+			return fmt.Errorf("failed to read content file: %w", err)
+		}
+		createOpts.Content = string(content)
+	}
+	if opts.PublishedAt != "" {
+		publishedAt, err := parseTime(opts.PublishedAt)
+		if err != nil {
+			return err
+		}
+		createOpts.PublishedAt = publishedAt
+	}
+
+	existing, err := wallabag.EntriesExist([]string{opts.URL})
+	if err != nil {
+		return err
+	}
+	id := existing[opts.URL]
+	updates := entryUpdates(createOpts)
+	if id != 0 && len(updates) == 0 {
+		fmt.Printf("✓ Page already saved (ID: %d)\n", id)
+		return nil
+	}
 
-	if err := wallabag.CreateEntry(opts.URL, opts.Tags, opts.Archive); err != nil {
+	item, err := wallabag.CreateEntry(createOpts)
+	if err != nil {
 		return err
 	}
 
-	fmt.Printf("✓ Page created successfully\n")
+	if id != 0 {
+		fmt.Printf("✓ Page already saved (ID: %d), updated %s\n", item.ID, strings.Join(updates, ", "))
+		return nil
+	}
+	fmt.Printf("✓ Page created successfully (ID: %d)\n", item.ID)
 	return nil
 }
 
+// entryUpdates lists what saving opts changes on an entry that already
+// exists; wallabag applies them when the URL is posted again.
+func entryUpdates(opts wallabag.CreateEntryOptions) []string {
+	var updates []string
+	if opts.Title != "" {
+		updates = append(updates, "title")
+	}
+	if opts.Content != "" {
+		updates = append(updates, "content")
+	}
+	if opts.Language != "" {
+		updates = append(updates, "language")
+	}
+	if !opts.PublishedAt.IsZero() {
+		updates = append(updates, "published date")
+	}
+	if opts.Authors != "" {
+		updates = append(updates, "authors")
+	}
+	if opts.Tags != "" {
+		updates = append(updates, "tags")
+	}
+	if opts.Starred {
+		updates = append(updates, "starred")
+	}
+	if opts.Archive {
+		updates = append(updates, "archived")
+	}
+	return updates
+}
+
+func (a *App) PagesExist(opts PagesExistOptions) error {
+	a.loadWallabag()
+
+	existing, err := wallabag.EntriesExist(opts.URLs)
+	if err != nil {
+		return err
+	}
+
+	items := make([]pageExists, 0, len(opts.URLs))
+	for _, u := range opts.URLs {
+		item := pageExists{URL: u, Exists: existing[u] != 0}
+		if item.Exists {
+			id := existing[u]
+			item.ID = &id
+		}
+		items = append(items, item)
+	}
+
+	data := map[string]any{
+		"total": len(items),
+		"items": items,
+	}
+
+	return format.Output(data, opts.JSON, opts.JQ)
+}
+
 func (a *App) ListPages(opts ListPagesOptions) error {
-	wallabag.LoadConfig(
-		a.Config.Wallabag.Endpoint,
-		a.Config.Wallabag.ClientID,
-		a.Config.Wallabag.ClientSecret,
-		a.Config.Wallabag.Username,
-		a.Config.Wallabag.Password,
-	)
+	a.loadWallabag()
 
 	listOpts := wallabag.ListEntriesOptions{
 		Archive: opts.Archive,
@@ -59,7 +223,13 @@ func (a *App) ListPages(opts ListPagesOptions) error {
 		Domain:  opts.Domain,
 	}
 
-	result, err := wallabag.ListEntries(listOpts)
+	var result *wallabag.ListEntriesResult
+	var err error
+	if opts.All {
+		result, err = wallabag.AllEntries(listOpts, opts.Max, opts.Concurrency)
+	} else {
+		result, err = wallabag.ListEntries(listOpts)
+	}
 	if err != nil {
 		return err
 	}
@@ -71,3 +241,274 @@ func (a *App) ListPages(opts ListPagesOptions) error {
 
 	return format.Output(data, opts.JSON, opts.JQ)
 }
+
+func (a *App) ShowPage(opts ShowPageOptions) error {
+	a.loadWallabag()
+
+	item, err := wallabag.GetEntry(opts.ID)
+	if err != nil {
+		return err
+	}
+
+	return format.Output(item, opts.JSON, opts.JQ)
+}
+
+func (a *App) UpdatePage(opts UpdatePageOptions) error {
+	a.loadWallabag()
+
+	_, err := wallabag.UpdateEntry(opts.ID, wallabag.UpdateEntryOptions{
+		Title:          opts.Title,
+		Tags:           opts.Tags,
+		Starred:        opts.Starred,
+		Archive:        opts.Archive,
+		Language:       opts.Language,
+		PreviewPicture: opts.PreviewPicture,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Page %d updated successfully\n", opts.ID)
+	return nil
+}
+
+func (a *App) StarPage(id int, starred bool) error {
+	a.loadWallabag()
+
+	if _, err := wallabag.UpdateEntry(id, wallabag.UpdateEntryOptions{Starred: &starred}); err != nil {
+		return err
+	}
+
+	if starred {
+		fmt.Printf("✓ Page %d starred\n", id)
+	} else {
+		fmt.Printf("✓ Page %d unstarred\n", id)
+	}
+	return nil
+}
+
+func (a *App) ArchivePage(id int, archive bool) error {
+	a.loadWallabag()
+
+	if _, err := wallabag.UpdateEntry(id, wallabag.UpdateEntryOptions{Archive: &archive}); err != nil {
+		return err
+	}
+
+	if archive {
+		fmt.Printf("✓ Page %d archived successfully\n", id)
+	} else {
+		fmt.Printf("✓ Page %d unarchived successfully\n", id)
+	}
+	return nil
+}
+
+func (a *App) DeletePage(id int) error {
+	a.loadWallabag()
+
+	if err := wallabag.DeleteEntry(id); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Page %d deleted successfully\n", id)
+	return nil
+}
+
+func (a *App) ReloadPage(id int) error {
+	a.loadWallabag()
+
+	if err := wallabag.ReloadEntry(id); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Page %d reloaded successfully\n", id)
+	return nil
+}
+
+// ReadPage renders the stored content of a page as terminal text shown
+// through the pager, or as Markdown written to stdout.
+func (a *App) ReadPage(opts ReadPageOptions) error {
+	a.loadWallabag()
+
+	item, err := wallabag.GetEntry(opts.ID)
+	if err != nil {
+		return err
+	}
+
+	meta := []string{}
+	if item.DomainName != "" {
+		meta = append(meta, item.DomainName)
+	}
+	if len(item.PublishedBy) > 0 {
+		meta = append(meta, strings.Join(item.PublishedBy, ", "))
+	}
+	if item.PublishedAt != nil && !item.PublishedAt.Time.IsZero() {
+		meta = append(meta, item.PublishedAt.Time.Format("2006-01-02"))
+	}
+	if item.ReadingTime > 0 {
+		meta = append(meta, fmt.Sprintf("%d min read", item.ReadingTime))
+	}
+
+	text, err := renderArticle(item.Title, item.URL, meta, item.Content, opts.Markdown)
+	if err != nil {
+		return fmt.Errorf("failed to render page: %w", err)
+	}
+
+	if opts.Markdown {
+		fmt.Print(text)
+		return nil
+	}
+	return showPaged(text)
+}
+
+// ExportPages exports one page, or every page matching the filters when no ID
+// is given. Wallabag's API exports entries one at a time, so the bulk form
+// writes one file per page into the output directory.
+func (a *App) ExportPages(opts ExportPagesOptions) error {
+	if opts.ID != 0 && (opts.Archive != -1 || opts.Starred != -1 || opts.Tags != "" || opts.Domain != "") {
+		return fmt.Errorf("--archive, --starred, --tags and --domain cannot be used with a page ID")
+	}
+
+	a.loadWallabag()
+
+	if opts.ID != 0 {
+		data, err := wallabag.ExportEntry(opts.ID, opts.Format)
+		if err != nil {
+			return err
+		}
+
+		if opts.Output == "" {
+			_, err := os.Stdout.Write(data)
+			return err
+		}
+		if err := os.WriteFile(opts.Output, data, 0o644); err != nil {
+			return fmt.Errorf("failed to write output file: %w", err)
+		}
+
+		fmt.Printf("✓ Exported page %d to %s\n", opts.ID, opts.Output)
+		return nil
+	}
+
+	if opts.Output == "" {
+		return fmt.Errorf("exporting several pages requires -o with an output directory")
+	}
+
+	result, err := wallabag.AllEntries(wallabag.ListEntriesOptions{
+		Archive: opts.Archive,
+		Starred: opts.Starred,
+		Tags:    opts.Tags,
+		Domain:  opts.Domain,
+	}, 0, 1)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(opts.Output, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	for i, item := range result.Items {
+		data, err := wallabag.ExportEntry(item.ID, opts.Format)
+		if err != nil {
+			return fmt.Errorf("page %d: %w", item.ID, err)
+		}
+
+		name := filepath.Join(opts.Output, fmt.Sprintf("%d-%s.%s", item.ID, slug(item.Title), opts.Format))
+		if err := os.WriteFile(name, data, 0o644); err != nil {
+			return fmt.Errorf("failed to write output file: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "[%d/%d] %s\n", i+1, len(result.Items), name)
+	}
+
+	fmt.Printf("✓ Exported %d pages to %s\n", len(result.Items), opts.Output)
+	return nil
+}
+
+// slug turns a title into a short file name component.
+func slug(title string) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+			dash = false
+		} else if !dash && sb.Len() > 0 {
+			sb.WriteByte('-')
+			dash = true
+		}
+		if sb.Len() >= 60 {
+			break
+		}
+	}
+	s := strings.TrimSuffix(sb.String(), "-")
+	if s == "" {
+		return "page"
+	}
+	return s
+}
+
+func (a *App) ListPageTags(opts ListPageTagsOptions) error {
+	a.loadWallabag()
+
+	tags, err := wallabag.Tags()
+	if err != nil {
+		return err
+	}
+
+	counts, err := wallabag.TagCounts()
+	if err != nil {
+		return err
+	}
+
+	items := make([]pageTagWithCount, 0, len(tags))
+	for _, tag := range tags {
+		items = append(items, pageTagWithCount{
+			ID:    tag.ID,
+			Label: tag.Label,
+			Slug:  tag.Slug,
+			Count: counts[tag.ID],
+		})
+	}
+	slices.SortFunc(items, func(x, y pageTagWithCount) int {
+		return strings.Compare(strings.ToLower(x.Label), strings.ToLower(y.Label))
+	})
+
+	data := map[string]any{
+		"total": len(items),
+		"items": items,
+	}
+
+	return format.Output(data, opts.JSON, opts.JQ)
+}
+
+func (a *App) AddPageTags(id int, tags []string) error {
+	a.loadWallabag()
+
+	if err := wallabag.AddEntryTags(id, tags); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Tags added to page %d\n", id)
+	return nil
+}
+
+func (a *App) RemovePageTag(id int, label string) error {
+	a.loadWallabag()
+
+	if err := wallabag.RemoveEntryTag(id, label); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Tag %q removed from page %d\n", label, id)
+	return nil
+}
+
+func (a *App) DeletePageTag(label string) error {
+	a.loadWallabag()
+
+	if err := wallabag.DeleteTag(label); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Tag %q deleted from all pages\n", label)
+	return nil
+}
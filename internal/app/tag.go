@@ -0,0 +1,126 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/goofansu/mlwcli/internal/format"
+	"github.com/goofansu/mlwcli/internal/linkding"
+)
+
+type ListTagsOptions struct {
+	JSON string
+	JQ   string
+}
+
+type PruneTagsOptions struct {
+	JSON string
+	JQ   string
+}
+
+type tagWithCount struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func (a *App) ListTags(opts ListTagsOptions) error {
+	tags, err := a.tagsWithCounts()
+	if err != nil {
+		return fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	data := map[string]any{
+		"total": len(tags),
+		"items": tags,
+	}
+
+	return format.Output(data, opts.JSON, opts.JQ)
+}
+
+func (a *App) RenameTag(oldName, newName string) error {
+	// Linkding matches tag names case-insensitively and keeps the existing
+	// tag, so a rename that only changes case would not change anything.
+	if strings.EqualFold(oldName, newName) {
+		return fmt.Errorf("cannot rename %q to %q: linkding tag names are case-insensitive, so only changing the case has no effect", oldName, newName)
+	}
+
+	updated, err := linkding.RetagBookmarks(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, []string{oldName}, newName)
+	if err != nil {
+		return fmt.Errorf("failed to rename tag: %w", err)
+	}
+
+	fmt.Printf("✓ Tag %q renamed to %q on %d links\n", oldName, newName, updated)
+	return nil
+}
+
+// MergeTags moves every link tagged with one of sources to target.
+func (a *App) MergeTags(target string, sources []string) error {
+	from := []string{}
+	for _, source := range sources {
+		if !strings.EqualFold(source, target) {
+			from = append(from, source)
+		}
+	}
+	if len(from) == 0 {
+		return fmt.Errorf("no tags to merge into %q", target)
+	}
+
+	updated, err := linkding.RetagBookmarks(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey, from, target)
+	if err != nil {
+		return fmt.Errorf("failed to merge tags: %w", err)
+	}
+
+	fmt.Printf("✓ Merged %s into %q on %d links\n", strings.Join(from, ", "), target, updated)
+	return nil
+}
+
+// PruneTags reports tags that are not used by any link. Linkding's API
+// cannot delete tags, so they have to be removed in its web interface.
+func (a *App) PruneTags(opts PruneTagsOptions) error {
+	tags, err := a.tagsWithCounts()
+	if err != nil {
+		return fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	unused := []tagWithCount{}
+	for _, tag := range tags {
+		if tag.Count == 0 {
+			unused = append(unused, tag)
+		}
+	}
+
+	data := map[string]any{
+		"total": len(unused),
+		"items": unused,
+	}
+
+	return format.Output(data, opts.JSON, opts.JQ)
+}
+
+func (a *App) tagsWithCounts() ([]tagWithCount, error) {
+	tags, err := linkding.Tags(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := linkding.TagCounts(a.Config.Linkding.Endpoint, a.Config.Linkding.APIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]tagWithCount, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, tagWithCount{
+			ID:    tag.ID,
+			Name:  tag.Name,
+			Count: counts[strings.ToLower(tag.Name)],
+		})
+	}
+	slices.SortFunc(result, func(x, y tagWithCount) int {
+		return strings.Compare(strings.ToLower(x.Name), strings.ToLower(y.Name))
+	})
+
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/goofansu/mlwcli/internal/render"
+	"golang.org/x/term"
+)
+
+const maxTextWidth = 100
+
+// terminalWidth returns the width used to wrap rendered text, capped at
+// maxTextWidth so long lines stay readable on wide terminals.
+func terminalWidth() int {
+	width, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 {
+		return 80
+	}
+	if width > maxTextWidth {
+		return maxTextWidth
+	}
+	return width
+}
+
+// renderArticle renders HTML content under a header with the title, the
+// metadata joined on one line and the URL, as Markdown or as terminal text
+// wrapped to the terminal width.
+func renderArticle(title, url string, meta []string, content string, markdown bool) (string, error) {
+	var sb strings.Builder
+	var body string
+	var err error
+	if markdown {
+		body, err = render.Markdown(content)
+		fmt.Fprintf(&sb, "# %s\n\n", title)
+		if len(meta) > 0 {
+			fmt.Fprintf(&sb, "%s  \n", strings.Join(meta, " · "))
+		}
+		fmt.Fprintf(&sb, "<%s>\n\n", url)
+	} else {
+		body, err = render.Text(content, terminalWidth())
+		fmt.Fprintf(&sb, "%s\n", title)
+		if len(meta) > 0 {
+			fmt.Fprintf(&sb, "%s\n", strings.Join(meta, " · "))
+		}
+		fmt.Fprintf(&sb, "%s\n\n", url)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	sb.WriteString(body)
+	return sb.String(), nil
+}
+
+// showPaged writes text to stdout through $PAGER (or less) when stdout is a
+// terminal, and directly otherwise.
+func showPaged(text string) error {
+	if !term.IsTerminal(int(os.Stdout.Fd())) {
+		_, err := os.Stdout.WriteString(text)
+		return err
+	}
+
+	pager := os.Getenv("PAGER")
+	if pager == "" {
+		if _, err := exec.LookPath("less"); err != nil {
+			_, err := os.Stdout.WriteString(text)
+			return err
+		}
+		pager = "less"
+	}
+
+	cmd := exec.Command("sh", "-c", pager)
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if os.Getenv("LESS") == "" {
+		cmd.Env = append(os.Environ(), "LESS=FRX")
+	}
+	return cmd.Run()
+}
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var relativeUnits = map[byte]time.Duration{
+	'm': time.Minute,
+	'h': time.Hour,
+	'd': 24 * time.Hour,
+	'w': 7 * 24 * time.Hour,
+}
+
+// parseTime parses an RFC3339 timestamp, a YYYY-MM-DD date in local time, or
+// a relative value such as 30m, 12h, 7d or 2w meaning that long ago.
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return t, nil
+	}
+	if len(value) > 1 {
+		unit, ok := relativeUnits[value[len(value)-1]]
+		n, err := strconv.Atoi(strings.TrimSpace(value[:len(value)-1]))
+		if ok && err == nil && n >= 0 {
+			return time.Now().Add(-time.Duration(n) * unit), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q (expected RFC3339, YYYY-MM-DD or a relative value like 7d)", value)
+}
+
+// parseUnixTime is like parseTime but returns a Unix timestamp, or 0 when
+// value is empty.
+func parseUnixTime(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	t, err := parseTime(value)
+	if err != nil {
+		return 0, err
+	}
+	return t.Unix(), nil
+}
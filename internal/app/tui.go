@@ -0,0 +1,36 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	api "miniflux.app/v2/client"
+)
+
+// promptSubscription displays a menu to pick one of the discovered feeds
+// and returns its URL
+func promptSubscription(subscriptions api.Subscriptions) (string, error) {
+	var url string
+
+	options := make([]huh.Option[string], 0, len(subscriptions))
+	for _, s := range subscriptions {
+		label := fmt.Sprintf("%s (%s) - %s", s.Title, s.Type, s.URL)
+		options = append(options, huh.NewOption(label, s.URL))
+	}
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Select a feed to subscribe to").
+				Description("Use arrow keys to navigate, Enter to select").
+				Options(options...).
+				Value(&url),
+		),
+	)
+
+	if err := form.Run(); err != nil {
+		return "", fmt.Errorf("failed to select feed: %w", err)
+	}
+
+	return url, nil
+}
@@ -80,7 +80,14 @@ func filterFields(data any, fields string) (map[string]any, error) {
 
 	v, ok := raw.(map[string]any)
 	if !ok {
-		return nil, fmt.Errorf("expected map with 'items' field")
+		return nil, fmt.Errorf("expected a JSON object")
+	}
+
+	fieldList := strings.Split(fields, ",")
+
+	// Single objects, such as the output of show commands, have no items.
+	if _, ok := v["items"]; !ok {
+		return filterMapFields(v, fieldList), nil
 	}
 
 	items, ok := v["items"].([]any)
@@ -88,7 +95,6 @@ func filterFields(data any, fields string) (map[string]any, error) {
 		return nil, fmt.Errorf("expected 'items' to be an array")
 	}
 
-	fieldList := strings.Split(fields, ",")
 	filteredItems := make([]map[string]any, len(items))
 	for i, item := range items {
 		if m, ok := item.(map[string]any); ok {
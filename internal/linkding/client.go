@@ -1,40 +1,459 @@
 package linkding
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
 	api "github.com/piero-vic/go-linkding"
+
+	"github.com/goofansu/mlwcli/internal/pager"
 )
 
 type CreateBookmarkOptions struct {
-	URL      string
-	Notes    string
-	TagNames []string
+	URL         string
+	Title       string
+	Description string
+	Notes       string
+	TagNames    []string
+	Unread      bool
+	Shared      bool
+}
+
+type UpdateBookmarkOptions struct {
+	Title       *string
+	Description *string
+	Notes       *string
+	TagNames    []string
+	AddTags     []string
+	RemoveTags  []string
+	Unread      *bool
+	Shared      *bool
 }
 
 type ListBookmarksOptions struct {
-	Query  string
-	Limit  int
-	Offset int
+	Query      string
+	Limit      int
+	Offset     int
+	Archived   bool
+	Unread     bool
+	Shared     bool
+	AddedSince time.Time
+	Sort       string
+	Bundle     *Bundle
+}
+
+// Bundle is a saved search with tag rules. Tags are separated by spaces.
+type Bundle struct {
+	ID           int       `json:"id"`
+	Name         string    `json:"name"`
+	Search       string    `json:"search"`
+	AnyTags      string    `json:"any_tags"`
+	AllTags      string    `json:"all_tags"`
+	ExcludedTags string    `json:"excluded_tags"`
+	Order        int       `json:"order"`
+	DateCreated  time.Time `json:"date_created"`
+	DateModified time.Time `json:"date_modified"`
+}
+
+type CreateBundleOptions struct {
+	Name         string `json:"name"`
+	Search       string `json:"search"`
+	AnyTags      string `json:"any_tags"`
+	AllTags      string `json:"all_tags"`
+	ExcludedTags string `json:"excluded_tags"`
 }
 
 func CreateBookmark(endpoint, apiKey string, opts CreateBookmarkOptions) (*api.Bookmark, error) {
 	client := api.NewClient(endpoint, apiKey)
 
 	req := api.CreateBookmarkRequest{
-		URL:      opts.URL,
-		Notes:    opts.Notes,
-		TagNames: opts.TagNames,
+		URL:         opts.URL,
+		Title:       opts.Title,
+		Description: opts.Description,
+		Notes:       opts.Notes,
+		TagNames:    opts.TagNames,
+		Unread:      opts.Unread,
+		Shared:      opts.Shared,
 	}
 
 	return client.CreateBookmark(req)
 }
 
+// ListBookmarks lists bookmarks, or archived bookmarks when opts.Archived is
+// set. It calls the API directly because go-linkding does not support the
+// shared, added_since, sort and bundle parameters. Linkding evaluates the
+// bundle's rules itself and combines them with the search query.
 func ListBookmarks(endpoint, apiKey string, opts ListBookmarksOptions) (*api.ListBookmarksResponse, error) {
+	path := "/api/bookmarks/"
+	if opts.Archived {
+		path = "/api/bookmarks/archived/"
+	}
+
+	values := url.Values{}
+	if opts.Query != "" {
+		values.Set("q", opts.Query)
+	}
+	if opts.Bundle != nil {
+		values.Set("bundle", strconv.Itoa(opts.Bundle.ID))
+	}
+	if opts.Limit > 0 {
+		values.Set("limit", strconv.Itoa(opts.Limit))
+	}
+	if opts.Offset > 0 {
+		values.Set("offset", strconv.Itoa(opts.Offset))
+	}
+	if opts.Unread {
+		values.Set("unread", "yes")
+	}
+	if opts.Shared {
+		values.Set("shared", "yes")
+	}
+	if !opts.AddedSince.IsZero() {
+		values.Set("added_since", opts.AddedSince.Format(time.RFC3339))
+	}
+	if opts.Sort != "" {
+		values.Set("sort", opts.Sort)
+	}
+	if len(values) > 0 {
+		path += "?" + values.Encode()
+	}
+
+	result := &api.ListBookmarksResponse{}
+	if err := requestJSON(endpoint, apiKey, http.MethodGet, path, nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CheckBookmark looks up url, returning the existing bookmark (nil when the URL
+// is not bookmarked yet) and the metadata linkding scraped from the page.
+func CheckBookmark(endpoint, apiKey, url string) (*api.CheckBookmarkResponse, error) {
 	client := api.NewClient(endpoint, apiKey)
-	return client.ListBookmarks(api.ListBookmarksParams{
-		Query:  opts.Query,
-		Limit:  opts.Limit,
-		Offset: opts.Offset,
+	return client.CheckBookmark(url)
+}
+
+func GetBookmark(endpoint, apiKey string, id int) (*api.Bookmark, error) {
+	client := api.NewClient(endpoint, apiKey)
+	return client.GetBookmark(id)
+}
+
+// UpdateBookmark applies opts on top of the current state of the bookmark.
+// TagNames replaces all tags when non-nil; AddTags and RemoveTags are applied
+// afterwards.
+func UpdateBookmark(endpoint, apiKey string, id int, opts UpdateBookmarkOptions) (*api.Bookmark, error) {
+	client := api.NewClient(endpoint, apiKey)
+
+	bookmark, err := client.GetBookmark(id)
+	if err != nil {
+		return nil, err
+	}
+
+	req := api.CreateBookmarkRequest{
+		URL:         bookmark.URL,
+		Title:       bookmark.Title,
+		Description: bookmark.Description,
+		Notes:       bookmark.Notes,
+		IsArchived:  bookmark.IsArchived,
+		Unread:      bookmark.Unread,
+		Shared:      bookmark.Shared,
+		TagNames:    bookmark.TagNames,
+	}
+	if opts.Title != nil {
+		req.Title = *opts.Title
+	}
+	if opts.Description != nil {
+		req.Description = *opts.Description
+	}
+	if opts.Notes != nil {
+		req.Notes = *opts.Notes
+	}
+	if opts.Unread != nil {
+		req.Unread = *opts.Unread
+	}
+	if opts.Shared != nil {
+		req.Shared = *opts.Shared
+	}
+	if opts.TagNames != nil {
+		req.TagNames = opts.TagNames
+	}
+	req.TagNames = mergeTags(req.TagNames, opts.AddTags, opts.RemoveTags)
+
+	return client.UpdateBookmark(id, req)
+}
+
+func DeleteBookmark(endpoint, apiKey string, id int) error {
+	client := api.NewClient(endpoint, apiKey)
+	return client.DeleteBookmark(id)
+}
+
+func ArchiveBookmark(endpoint, apiKey string, id int) error {
+	client := api.NewClient(endpoint, apiKey)
+	return client.ArchiveBookmark(id)
+}
+
+func UnarchiveBookmark(endpoint, apiKey string, id int) error {
+	client := api.NewClient(endpoint, apiKey)
+	return client.UnarchiveBookmark(id)
+}
+
+// mergeTags returns tags with add appended and remove taken out, without
+// duplicates. The result is never nil.
+func mergeTags(tags, add, remove []string) []string {
+	removed := map[string]bool{}
+	for _, tag := range remove {
+		removed[tag] = true
+	}
+
+	seen := map[string]bool{}
+	result := []string{}
+	for _, tag := range slices.Concat(tags, add) {
+		if tag == "" || removed[tag] || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// AllBookmarks walks all pages of bookmarks matching opts, starting at
+// opts.Offset, and returns at most maxItems bookmarks (all when maxItems is 0).
+func AllBookmarks(endpoint, apiKey string, opts ListBookmarksOptions, maxItems, concurrency int) (*api.ListBookmarksResponse, error) {
+	opts.Limit = pager.PageSize
+	first, err := ListBookmarks(endpoint, apiKey, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := pager.Pages(first.Count-opts.Offset, maxItems, pager.PageSize)
+	rest, err := pager.Fetch(max(pages-1, 0), concurrency, func(page int) ([]api.Bookmark, error) {
+		pageOpts := opts
+		pageOpts.Offset = opts.Offset + (page+1)*pager.PageSize
+		result, err := ListBookmarks(endpoint, apiKey, pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		return result.Results, nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	bookmarks := append(first.Results, rest...)
+	if maxItems > 0 && len(bookmarks) > maxItems {
+		bookmarks = bookmarks[:maxItems]
+	}
+
+	return &api.ListBookmarksResponse{Count: first.Count, Results: bookmarks}, nil
+}
+
+// EveryBookmark returns all bookmarks followed by all archived bookmarks.
+func EveryBookmark(endpoint, apiKey string, concurrency int) ([]api.Bookmark, error) {
+	var bookmarks []api.Bookmark
+	for _, archived := range []bool{false, true} {
+		result, err := AllBookmarks(endpoint, apiKey, ListBookmarksOptions{Archived: archived}, 0, concurrency)
+		if err != nil {
+			return nil, err
+		}
+		bookmarks = append(bookmarks, result.Results...)
+	}
+	return bookmarks, nil
+}
+
+func BookmarkAssets(endpoint, apiKey string, bookmarkID int) (*api.ListBookmarkAssetsResponse, error) {
+	client := api.NewClient(endpoint, apiKey)
+	return client.ListBookmarkAssets(bookmarkID)
+}
+
+// DownloadBookmarkAsset copies the content of an asset, such as an HTML
+// snapshot or an uploaded file, to w.
+func DownloadBookmarkAsset(endpoint, apiKey string, bookmarkID, assetID int, w io.Writer) error {
+	body, err := request(endpoint, apiKey, http.MethodGet, fmt.Sprintf("/api/bookmarks/%d/assets/%d/download/", bookmarkID, assetID), nil, "")
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	_, err = io.Copy(w, body)
+	return err
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// UploadBookmarkAsset attaches the content of r to a bookmark as a file
+// named name. Linkding keeps the content type, which is guessed from the
+// file extension.
+func UploadBookmarkAsset(endpoint, apiKey string, bookmarkID int, name string, r io.Reader) (*api.BookmarkAsset, error) {
+	contentType := mime.TypeByExtension(filepath.Ext(name))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(name)))
+	header.Set("Content-Type", contentType)
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return nil, err
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	body, err := request(endpoint, apiKey, http.MethodPost, fmt.Sprintf("/api/bookmarks/%d/assets/upload/", bookmarkID), &buf, mw.FormDataContentType())
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	asset := &api.BookmarkAsset{}
+	if err := json.NewDecoder(body).Decode(asset); err != nil {
+		return nil, err
+	}
+	return asset, nil
+}
+
+func Bundles(endpoint, apiKey string) ([]Bundle, error) {
+	var bundles []Bundle
+	for {
+		var result struct {
+			Next    string   `json:"next"`
+			Results []Bundle `json:"results"`
+		}
+		path := fmt.Sprintf("/api/bundles/?limit=%d&offset=%d", pager.PageSize, len(bundles))
+		if err := requestJSON(endpoint, apiKey, http.MethodGet, path, nil, &result); err != nil {
+			return nil, err
+		}
+		bundles = append(bundles, result.Results...)
+		if result.Next == "" || len(result.Results) == 0 {
+			return bundles, nil
+		}
+	}
+}
+
+// FindBundle returns the bundle with the given ID or, failing that, the given
+// name (case-insensitive).
+func FindBundle(endpoint, apiKey, nameOrID string) (*Bundle, error) {
+	bundles, err := Bundles(endpoint, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if id, err := strconv.Atoi(nameOrID); err == nil {
+		for i := range bundles {
+			if bundles[i].ID == id {
+				return &bundles[i], nil
+			}
+		}
+	}
+	for i := range bundles {
+		if strings.EqualFold(bundles[i].Name, nameOrID) {
+			return &bundles[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("bundle %q not found", nameOrID)
+}
+
+func CreateBundle(endpoint, apiKey string, opts CreateBundleOptions) (*Bundle, error) {
+	bundle := &Bundle{}
+	if err := requestJSON(endpoint, apiKey, http.MethodPost, "/api/bundles/", opts, bundle); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+func DeleteBundle(endpoint, apiKey string, id int) error {
+	return requestJSON(endpoint, apiKey, http.MethodDelete, fmt.Sprintf("/api/bundles/%d/", id), nil, nil)
+}
+
+func Tags(endpoint, apiKey string) ([]api.Tag, error) {
+	client := api.NewClient(endpoint, apiKey)
+
+	var tags []api.Tag
+	for {
+		result, err := client.ListTags(api.ListTagsParams{Limit: pager.PageSize, Offset: len(tags)})
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, result.Results...)
+		if result.Next == "" || len(result.Results) == 0 {
+			return tags, nil
+		}
+	}
+}
+
+// TagCounts returns the number of bookmarks, archived ones included, using
+// each tag name. Names are compared case-insensitively, as linkding does.
+func TagCounts(endpoint, apiKey string) (map[string]int, error) {
+	bookmarks, err := EveryBookmark(endpoint, apiKey, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+	for _, bookmark := range bookmarks {
+		for _, tag := range bookmark.TagNames {
+			counts[strings.ToLower(tag)]++
+		}
+	}
+	return counts, nil
+}
+
+// RetagBookmarks replaces the tags in from with to on every bookmark,
+// archived ones included, and returns the number of bookmarks updated.
+// Linkding has no API to rename tags, so this is how renames and merges are
+// done.
+func RetagBookmarks(endpoint, apiKey string, from []string, to string) (int, error) {
+	updated := 0
+	for _, tag := range from {
+		for _, archived := range []bool{false, true} {
+			result, err := AllBookmarks(endpoint, apiKey, ListBookmarksOptions{Query: "#" + tag, Archived: archived}, 0, 1)
+			if err != nil {
+				return updated, err
+			}
+
+			for _, bookmark := range result.Results {
+				remove := []string{}
+				for _, name := range bookmark.TagNames {
+					if strings.EqualFold(name, tag) {
+						remove = append(remove, name)
+					}
+				}
+				if len(remove) == 0 {
+					continue
+				}
+
+				_, err := UpdateBookmark(endpoint, apiKey, bookmark.ID, UpdateBookmarkOptions{
+					AddTags:    []string{to},
+					RemoveTags: remove,
+				})
+				if err != nil {
+					return updated, fmt.Errorf("bookmark %d: %w", bookmark.ID, err)
+				}
+				updated++
+			}
+		}
+	}
+	return updated, nil
 }
 
 func Validate(endpoint, apiKey string) error {
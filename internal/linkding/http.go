@@ -0,0 +1,67 @@
+package linkding
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// request calls the linkding API directly, for the parameters and endpoints
+// go-linkding does not cover. The caller must close the returned body.
+func request(endpoint, apiKey, method, path string, body io.Reader, contentType string) (io.ReadCloser, error) {
+	req, err := http.NewRequest(method, strings.TrimSuffix(endpoint, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Token "+apiKey)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		defer res.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		if len(bytes.TrimSpace(msg)) == 0 {
+			return nil, fmt.Errorf("linkding: %s", res.Status)
+		}
+		return nil, fmt.Errorf("linkding: %s (%s)", res.Status, bytes.TrimSpace(msg))
+	}
+
+	return res.Body, nil
+}
+
+// requestJSON sends payload (if not nil) as JSON and decodes the response
+// into result (if not nil).
+func requestJSON(endpoint, apiKey, method, path string, payload, result any) error {
+	var body io.Reader
+	contentType := ""
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+
+	resBody, err := request(endpoint, apiKey, method, path, body, contentType)
+	if err != nil {
+		return err
+	}
+	defer resBody.Close()
+
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resBody).Decode(result)
+}
@@ -2,6 +2,8 @@ package miniflux
 
 import (
 	api "miniflux.app/v2/client"
+
+	"github.com/goofansu/mlwcli/internal/pager"
 )
 
 type CreateFeedOptions struct {
@@ -20,28 +22,97 @@ func CreateFeed(endpoint, apiKey string, opts CreateFeedOptions) (int64, error)
 	return client.CreateFeed(req)
 }
 
+func Discover(endpoint, apiKey, url string) (api.Subscriptions, error) {
+	client := api.NewClient(endpoint, apiKey)
+	return client.Discover(url)
+}
+
+type UpdateFeedOptions struct {
+	Title        *string
+	CategoryID   *int64
+	Crawler      *bool
+	ScraperRules *string
+	RewriteRules *string
+	UserAgent    *string
+	Disabled     *bool
+}
+
+func Feed(endpoint, apiKey string, feedID int64) (*api.Feed, error) {
+	client := api.NewClient(endpoint, apiKey)
+	return client.Feed(feedID)
+}
+
+func UpdateFeed(endpoint, apiKey string, feedID int64, opts UpdateFeedOptions) (*api.Feed, error) {
+	client := api.NewClient(endpoint, apiKey)
+
+	req := &api.FeedModificationRequest{
+		Title:        opts.Title,
+		CategoryID:   opts.CategoryID,
+		Crawler:      opts.Crawler,
+		ScraperRules: opts.ScraperRules,
+		RewriteRules: opts.RewriteRules,
+		UserAgent:    opts.UserAgent,
+		Disabled:     opts.Disabled,
+	}
+
+	return client.UpdateFeed(feedID, req)
+}
+
+func RefreshFeed(endpoint, apiKey string, feedID int64) error {
+	client := api.NewClient(endpoint, apiKey)
+	return client.RefreshFeed(feedID)
+}
+
+func RefreshAllFeeds(endpoint, apiKey string) error {
+	client := api.NewClient(endpoint, apiKey)
+	return client.RefreshAllFeeds()
+}
+
+func DeleteFeed(endpoint, apiKey string, feedID int64) error {
+	client := api.NewClient(endpoint, apiKey)
+	return client.DeleteFeed(feedID)
+}
+
 type EntriesOptions struct {
-	FeedID  int64
-	Search  string
-	Starred string
-	Limit   int
-	Status  string
-	Offset  int
+	FeedID          int64
+	CategoryID      int64
+	Search          string
+	Starred         string
+	Limit           int
+	Status          string
+	Offset          int
+	Order           string
+	Direction       string
+	PublishedBefore int64
+	PublishedAfter  int64
+	ChangedAfter    int64
 }
 
 func Entries(endpoint, apiKey string, opts EntriesOptions) (*api.EntryResultSet, error) {
 	client := api.NewClient(endpoint, apiKey)
 
 	filter := &api.Filter{
-		Search:    opts.Search,
-		Limit:     opts.Limit,
-		Offset:    opts.Offset,
-		Order:     "published_at",
-		Direction: "desc",
+		Search:          opts.Search,
+		Limit:           opts.Limit,
+		Offset:          opts.Offset,
+		Order:           "published_at",
+		Direction:       "desc",
+		PublishedBefore: opts.PublishedBefore,
+		PublishedAfter:  opts.PublishedAfter,
+		ChangedAfter:    opts.ChangedAfter,
+	}
+	if opts.Order != "" {
+		filter.Order = opts.Order
+	}
+	if opts.Direction != "" {
+		filter.Direction = opts.Direction
 	}
 	if opts.FeedID != 0 {
 		filter.FeedID = opts.FeedID
 	}
+	if opts.CategoryID != 0 {
+		filter.CategoryID = opts.CategoryID
+	}
 	if opts.Starred != "" {
 		filter.Starred = opts.Starred
 	}
@@ -52,11 +123,101 @@ func Entries(endpoint, apiKey string, opts EntriesOptions) (*api.EntryResultSet,
 	return client.Entries(filter)
 }
 
+func MarkFeedAsRead(endpoint, apiKey string, feedID int64) error {
+	client := api.NewClient(endpoint, apiKey)
+	return client.MarkFeedAsRead(feedID)
+}
+
+func MarkAllAsRead(endpoint, apiKey string) error {
+	client := api.NewClient(endpoint, apiKey)
+	user, err := client.Me()
+	if err != nil {
+		return err
+	}
+	return client.MarkAllAsRead(user.ID)
+}
+
+// AllEntries walks all pages of entries matching opts, starting at
+// opts.Offset, and returns at most maxItems entries (all when maxItems is 0).
+func AllEntries(endpoint, apiKey string, opts EntriesOptions, maxItems, concurrency int) (*api.EntryResultSet, error) {
+	opts.Limit = pager.PageSize
+	first, err := Entries(endpoint, apiKey, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := pager.Pages(first.Total-opts.Offset, maxItems, pager.PageSize)
+	rest, err := pager.Fetch(max(pages-1, 0), concurrency, func(page int) ([]*api.Entry, error) {
+		pageOpts := opts
+		pageOpts.Offset = opts.Offset + (page+1)*pager.PageSize
+		result, err := Entries(endpoint, apiKey, pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		return result.Entries, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := append(first.Entries, rest...)
+	if maxItems > 0 && len(entries) > maxItems {
+		entries = entries[:maxItems]
+	}
+
+	return &api.EntryResultSet{Total: first.Total, Entries: entries}, nil
+}
+
+func Entry(endpoint, apiKey string, entryID int64) (*api.Entry, error) {
+	client := api.NewClient(endpoint, apiKey)
+	return client.Entry(entryID)
+}
+
+func UpdateEntries(endpoint, apiKey string, entryIDs []int64, status string) error {
+	client := api.NewClient(endpoint, apiKey)
+	return client.UpdateEntries(entryIDs, status)
+}
+
+func ToggleStarred(endpoint, apiKey string, entryID int64) error {
+	client := api.NewClient(endpoint, apiKey)
+	return client.ToggleStarred(entryID)
+}
+
 func Feeds(endpoint, apiKey string) (api.Feeds, error) {
 	client := api.NewClient(endpoint, apiKey)
 	return client.Feeds()
 }
 
+func Categories(endpoint, apiKey string) (api.Categories, error) {
+	client := api.NewClient(endpoint, apiKey)
+	return client.CategoriesWithCounters()
+}
+
+func CreateCategory(endpoint, apiKey, title string) (*api.Category, error) {
+	client := api.NewClient(endpoint, apiKey)
+	return client.CreateCategory(title)
+}
+
+func UpdateCategory(endpoint, apiKey string, categoryID int64, title string) (*api.Category, error) {
+	client := api.NewClient(endpoint, apiKey)
+	return client.UpdateCategory(categoryID, title)
+}
+
+func DeleteCategory(endpoint, apiKey string, categoryID int64) error {
+	client := api.NewClient(endpoint, apiKey)
+	return client.DeleteCategory(categoryID)
+}
+
+func MarkCategoryAsRead(endpoint, apiKey string, categoryID int64) error {
+	client := api.NewClient(endpoint, apiKey)
+	return client.MarkCategoryAsRead(categoryID)
+}
+
+func Counters(endpoint, apiKey string) (*api.FeedCounters, error) {
+	client := api.NewClient(endpoint, apiKey)
+	return client.FetchCounters()
+}
+
 func SaveEntry(endpoint, apiKey string, entryID int64) error {
 	client := api.NewClient(endpoint, apiKey)
 	return client.SaveEntry(entryID)
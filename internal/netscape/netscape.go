@@ -0,0 +1,193 @@
+package netscape
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Bookmark is a bookmark from a Netscape bookmark file. Tags include the
+// names of the folders the bookmark was found in.
+type Bookmark struct {
+	URL         string    `json:"url"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Tags        []string  `json:"tags"`
+	AddDate     time.Time `json:"add_date"`
+	Private     bool      `json:"private"`
+	ToRead      bool      `json:"to_read"`
+}
+
+// Parse reads a Netscape bookmark file as exported by browsers and bookmark
+// managers. Folder names become tags, except for the browser toolbar folder.
+func Parse(r io.Reader) ([]Bookmark, error) {
+	z := xhtml.NewTokenizer(r)
+
+	var bookmarks []Bookmark
+	var folders []string
+	folder := ""
+	var current *Bookmark
+	var text strings.Builder
+	capturing := atom.Atom(0)
+
+	for {
+		tt := z.Next()
+		switch tt {
+		case xhtml.ErrorToken:
+			if z.Err() == io.EOF {
+				if capturing == atom.Dd {
+					current.Description = strings.TrimSpace(text.String())
+				}
+				return bookmarks, nil
+			}
+			return nil, fmt.Errorf("failed to parse bookmarks: %w", z.Err())
+
+		case xhtml.TextToken:
+			if capturing != 0 {
+				text.Write(z.Text())
+			}
+
+		case xhtml.StartTagToken, xhtml.EndTagToken:
+			tok := z.Token()
+			// A description may contain markup and runs until the next
+			// bookmark, folder or list.
+			if capturing == atom.Dd {
+				switch tok.DataAtom {
+				case atom.Dt, atom.Dl, atom.H3:
+					current.Description = strings.TrimSpace(text.String())
+					capturing = 0
+				case atom.Br:
+					text.WriteString("\n")
+				}
+			}
+
+			switch {
+			case tt == xhtml.StartTagToken && tok.DataAtom == atom.H3:
+				folder = ""
+				current = nil
+				if attr(tok, "personal_toolbar_folder") != "true" {
+					capturing = atom.H3
+					text.Reset()
+				}
+			case tt == xhtml.EndTagToken && tok.DataAtom == atom.H3 && capturing == atom.H3:
+				folder = tagName(text.String())
+				capturing = 0
+			case tt == xhtml.StartTagToken && tok.DataAtom == atom.Dl:
+				folders = append(folders, folder)
+				folder = ""
+			case tt == xhtml.EndTagToken && tok.DataAtom == atom.Dl:
+				if len(folders) > 0 {
+					folders = folders[:len(folders)-1]
+				}
+			case tt == xhtml.StartTagToken && tok.DataAtom == atom.A:
+				href := strings.TrimSpace(attr(tok, "href"))
+				if href == "" || strings.HasPrefix(href, "place:") || strings.HasPrefix(href, "javascript:") {
+					current = nil
+					continue
+				}
+				bookmarks = append(bookmarks, Bookmark{
+					URL:     href,
+					Tags:    bookmarkTags(folders, attr(tok, "tags")),
+					AddDate: unixTime(attr(tok, "add_date")),
+					Private: attr(tok, "private") == "1",
+					ToRead:  attr(tok, "toread") == "1",
+				})
+				current = &bookmarks[len(bookmarks)-1]
+				capturing = atom.A
+				text.Reset()
+			case tt == xhtml.EndTagToken && tok.DataAtom == atom.A && capturing == atom.A:
+				current.Title = strings.TrimSpace(text.String())
+				capturing = 0
+			case tt == xhtml.StartTagToken && tok.DataAtom == atom.Dd && current != nil:
+				capturing = atom.Dd
+				text.Reset()
+			case tt == xhtml.StartTagToken && tok.DataAtom == atom.Dt:
+				current = nil
+			}
+		}
+	}
+}
+
+// Write writes bookmarks as a Netscape bookmark file that browsers and
+// bookmark managers can import.
+func Write(w io.Writer, bookmarks []Bookmark) error {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE NETSCAPE-Bookmark-file-1>\n")
+	b.WriteString("<META HTTP-EQUIV=\"Content-Type\" CONTENT=\"text/html; charset=UTF-8\">\n")
+	b.WriteString("<TITLE>Bookmarks</TITLE>\n")
+	b.WriteString("<H1>Bookmarks</H1>\n")
+	b.WriteString("<DL><p>\n")
+	for _, bm := range bookmarks {
+		fmt.Fprintf(&b, "    <DT><A HREF=\"%s\"", html.EscapeString(bm.URL))
+		if !bm.AddDate.IsZero() {
+			fmt.Fprintf(&b, " ADD_DATE=\"%d\"", bm.AddDate.Unix())
+		}
+		if bm.Private {
+			b.WriteString(" PRIVATE=\"1\"")
+		}
+		if bm.ToRead {
+			b.WriteString(" TOREAD=\"1\"")
+		}
+		if len(bm.Tags) > 0 {
+			fmt.Fprintf(&b, " TAGS=\"%s\"", html.EscapeString(strings.Join(bm.Tags, ",")))
+		}
+		fmt.Fprintf(&b, ">%s</A>\n", html.EscapeString(bm.Title))
+		if bm.Description != "" {
+			fmt.Fprintf(&b, "    <DD>%s\n", html.EscapeString(bm.Description))
+		}
+	}
+	b.WriteString("</DL><p>\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func attr(tok xhtml.Token, name string) string {
+	for _, a := range tok.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func bookmarkTags(folders []string, tags string) []string {
+	seen := map[string]bool{}
+	result := []string{}
+	add := func(tag string) {
+		if tag != "" && !seen[strings.ToLower(tag)] {
+			seen[strings.ToLower(tag)] = true
+			result = append(result, tag)
+		}
+	}
+	for _, folder := range folders {
+		add(folder)
+	}
+	for _, tag := range strings.Split(tags, ",") {
+		add(tagName(tag))
+	}
+	return result
+}
+
+// tagName turns a folder or tag name into a single-word tag.
+func tagName(name string) string {
+	return strings.Join(strings.Fields(name), "-")
+}
+
+func unixTime(value string) time.Time {
+	n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || n <= 0 {
+		return time.Time{}
+	}
+	// Some exporters write microseconds rather than seconds.
+	if n > 1e14 {
+		return time.UnixMicro(n)
+	}
+	return time.Unix(n, 0)
+}
@@ -0,0 +1,98 @@
+package netscape
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const browserExport = `<!DOCTYPE NETSCAPE-Bookmark-file-1>
+<!-- This is an automatically generated file.
+     It will be read and overwritten.
+     DO NOT EDIT! -->
+<META HTTP-EQUIV="Content-Type" CONTENT="text/html; charset=UTF-8">
+<TITLE>Bookmarks</TITLE>
+<H1>Bookmarks</H1>
+<DL><p>
+    <DT><H3 ADD_DATE="1700000000" LAST_MODIFIED="1700000001" PERSONAL_TOOLBAR_FOLDER="true">Bookmarks bar</H3>
+    <DL><p>
+        <DT><A HREF="https://go.dev/" ADD_DATE="1700000100">The Go Programming Language</A>
+        <DT><H3 ADD_DATE="1700000200">Dev Tools</H3>
+        <DL><p>
+            <DT><A HREF="https://github.com/" ADD_DATE="1700000300" TAGS="code,git">GitHub</A>
+            <DD>Where <b>code</b> lives &amp; grows
+            <DT><H3>Rust Stuff</H3>
+            <DD>Folder description
+            <DL><p>
+                <DT><A HREF="https://www.rust-lang.org/" ADD_DATE="1700000400000000">Rust</A>
+            </DL><p>
+        </DL><p>
+    </DL><p>
+    <DT><A HREF="place:sort=8&maxResults=10">Recent Tags</A>
+    <DT><A HREF="https://example.com/later" ADD_DATE="1700000500" PRIVATE="1" TOREAD="1">Read &lt;later&gt;</A>
+    <DD>First line<br>second line
+</DL><p>
+`
+
+func TestParse(t *testing.T) {
+	got, err := Parse(strings.NewReader(browserExport))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	want := []Bookmark{
+		{
+			URL:     "https://go.dev/",
+			Title:   "The Go Programming Language",
+			Tags:    []string{},
+			AddDate: time.Unix(1700000100, 0),
+		},
+		{
+			URL:         "https://github.com/",
+			Title:       "GitHub",
+			Description: "Where code lives & grows",
+			Tags:        []string{"Dev-Tools", "code", "git"},
+			AddDate:     time.Unix(1700000300, 0),
+		},
+		{
+			URL:     "https://www.rust-lang.org/",
+			Title:   "Rust",
+			Tags:    []string{"Dev-Tools", "Rust-Stuff"},
+			AddDate: time.UnixMicro(1700000400000000),
+		},
+		{
+			URL:         "https://example.com/later",
+			Title:       "Read <later>",
+			Description: "First line\nsecond line",
+			Tags:        []string{},
+			AddDate:     time.Unix(1700000500, 0),
+			Private:     true,
+			ToRead:      true,
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestWriteRoundTrip(t *testing.T) {
+	bookmarks, err := Parse(strings.NewReader(browserExport))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	var sb strings.Builder
+	if err := Write(&sb, bookmarks); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	got, err := Parse(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, bookmarks) {
+		t.Errorf("round trip =\n%+v\nwant\n%+v\nfile:\n%s", got, bookmarks, sb.String())
+	}
+}
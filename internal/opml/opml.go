@@ -0,0 +1,120 @@
+package opml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Subscription is a feed outline flattened together with its category.
+type Subscription struct {
+	Title    string `json:"title"`
+	FeedURL  string `json:"feed_url"`
+	SiteURL  string `json:"site_url"`
+	Category string `json:"category"`
+}
+
+type document struct {
+	XMLName xml.Name  `xml:"opml"`
+	Version string    `xml:"version,attr"`
+	Head    head      `xml:"head"`
+	Outline []outline `xml:"body>outline"`
+}
+
+type head struct {
+	Title       string `xml:"title,omitempty"`
+	DateCreated string `xml:"dateCreated,omitempty"`
+}
+
+type outline struct {
+	Text     string    `xml:"text,attr"`
+	Title    string    `xml:"title,attr,omitempty"`
+	Type     string    `xml:"type,attr,omitempty"`
+	XMLURL   string    `xml:"xmlUrl,attr,omitempty"`
+	HTMLURL  string    `xml:"htmlUrl,attr,omitempty"`
+	Category string    `xml:"category,attr,omitempty"`
+	Outlines []outline `xml:"outline"`
+}
+
+// Parse reads an OPML document and returns its feed subscriptions. Feeds take
+// the category of their nearest parent outline, falling back to the outline's
+// category attribute.
+func Parse(r io.Reader) ([]Subscription, error) {
+	var doc document
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to parse OPML: %w", err)
+	}
+
+	var subscriptions []Subscription
+	var walk func(outlines []outline, category string)
+	walk = func(outlines []outline, category string) {
+		for _, o := range outlines {
+			title := strings.TrimSpace(o.Title)
+			if title == "" {
+				title = strings.TrimSpace(o.Text)
+			}
+
+			if o.XMLURL == "" {
+				walk(o.Outlines, title)
+				continue
+			}
+
+			feedCategory := category
+			if feedCategory == "" {
+				feedCategory = strings.Trim(strings.TrimSpace(o.Category), "/")
+			}
+
+			subscriptions = append(subscriptions, Subscription{
+				Title:    title,
+				FeedURL:  strings.TrimSpace(o.XMLURL),
+				SiteURL:  strings.TrimSpace(o.HTMLURL),
+				Category: feedCategory,
+			})
+		}
+	}
+	walk(doc.Outline, "")
+
+	return subscriptions, nil
+}
+
+// Write writes subscriptions as an OPML document with one outline per
+// category, keeping the order in which categories first appear.
+func Write(w io.Writer, title string, subscriptions []Subscription) error {
+	doc := document{
+		Version: "2.0",
+		Head: head{
+			Title:       title,
+			DateCreated: time.Now().Format(time.RFC1123Z),
+		},
+	}
+
+	index := map[string]int{}
+	for _, s := range subscriptions {
+		i, ok := index[s.Category]
+		if !ok {
+			i = len(doc.Outline)
+			index[s.Category] = i
+			doc.Outline = append(doc.Outline, outline{Text: s.Category, Title: s.Category})
+		}
+		doc.Outline[i].Outlines = append(doc.Outline[i].Outlines, outline{
+			Text:    s.Title,
+			Title:   s.Title,
+			Type:    "rss",
+			XMLURL:  s.FeedURL,
+			HTMLURL: s.SiteURL,
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("failed to write OPML: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
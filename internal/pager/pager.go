@@ -0,0 +1,53 @@
+package pager
+
+import "sync"
+
+// PageSize is the number of items requested per page when walking all pages.
+const PageSize = 100
+
+// Pages returns how many pages of size are needed to fetch total items,
+// capped at maxItems items when maxItems is positive.
+func Pages(total, maxItems, size int) int {
+	if maxItems > 0 && maxItems < total {
+		total = maxItems
+	}
+	if total <= 0 || size <= 0 {
+		return 0
+	}
+	return (total + size - 1) / size
+}
+
+// Fetch calls fetch for every page in [0, pages) using up to concurrency
+// goroutines and returns the items concatenated in page order. The first
+// error encountered is returned.
+func Fetch[T any](pages, concurrency int, fetch func(page int) ([]T, error)) ([]T, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([][]T, pages)
+	errs := make([]error, pages)
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for page := range pages {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[page], errs[page] = fetch(page)
+		}()
+	}
+	wg.Wait()
+
+	var items []T
+	for page := range pages {
+		if errs[page] != nil {
+			return nil, errs[page]
+		}
+		items = append(items, results[page]...)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,648 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Markdown converts HTML content to Markdown with links collected as
+// reference-style footnotes at the end of the document.
+func Markdown(content string) (string, error) {
+	return convert(content, true, 0)
+}
+
+// Text converts HTML content to plain terminal text wrapped at width columns.
+// Links are numbered inline and listed at the end of the document.
+func Text(content string, width int) (string, error) {
+	return convert(content, false, width)
+}
+
+// block is a rendered paragraph. Consecutive blocks of the same non-zero
+// group, such as list items or table rows, are not separated by blank lines
+// unless the block continues a list item with another paragraph.
+type block struct {
+	text  string
+	group int
+	loose bool
+}
+
+type list struct {
+	ordered bool
+	count   int
+	marker  string
+	used    bool
+}
+
+type converter struct {
+	markdown bool
+	width    int
+	blocks   []block
+	inline   bytes.Buffer
+	heading  int
+	quote    int
+	code     int
+	rows     int
+	group    int
+	groups   int
+	lists    []*list
+	links    []string
+	linkRefs map[string]int
+}
+
+func convert(content string, markdown bool, width int) (string, error) {
+	doc, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	c := &converter{
+		markdown: markdown,
+		width:    width,
+		linkRefs: map[string]int{},
+	}
+	c.walk(doc)
+	c.flush()
+
+	return c.String(), nil
+}
+
+func (c *converter) String() string {
+	var sb strings.Builder
+	for i, b := range c.blocks {
+		if i > 0 {
+			if b.group != 0 && b.group == c.blocks[i-1].group && !b.loose {
+				sb.WriteString("\n")
+			} else {
+				sb.WriteString("\n\n")
+			}
+		}
+		sb.WriteString(b.text)
+	}
+
+	if len(c.links) > 0 {
+		if sb.Len() > 0 {
+			sb.WriteString("\n\n")
+		}
+		if !c.markdown {
+			sb.WriteString("Links:\n")
+		}
+		for i, link := range c.links {
+			if c.markdown {
+				fmt.Fprintf(&sb, "[%d]: %s\n", i+1, link)
+			} else {
+				fmt.Fprintf(&sb, "[%d] %s\n", i+1, link)
+			}
+		}
+		return sb.String()
+	}
+
+	if sb.Len() > 0 {
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func (c *converter) walk(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		c.writeText(n.Data)
+		return
+	case html.ElementNode:
+		c.element(n)
+		return
+	}
+
+	c.walkChildren(n)
+}
+
+func (c *converter) walkChildren(n *html.Node) {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		c.walk(child)
+	}
+}
+
+func (c *converter) element(n *html.Node) {
+	switch n.DataAtom {
+	case atom.Head, atom.Script, atom.Style, atom.Noscript, atom.Template, atom.Iframe:
+		return
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		c.flush()
+		c.heading = int(n.Data[1] - '0')
+		c.walkChildren(n)
+		c.flush()
+		c.heading = 0
+	case atom.Table:
+		c.flush()
+		defer c.startGroup()()
+		rows := c.rows
+		c.rows = 0
+		c.walkChildren(n)
+		c.flush()
+		c.rows = rows
+	case atom.Tr:
+		c.flush()
+		if c.markdown {
+			c.tableRow(n)
+			return
+		}
+		c.walkChildren(n)
+		c.flush()
+	case atom.P, atom.Div, atom.Section, atom.Article, atom.Header, atom.Footer,
+		atom.Figure, atom.Figcaption, atom.Dl, atom.Dt, atom.Dd,
+		atom.Main, atom.Aside, atom.Nav, atom.Details, atom.Summary:
+		c.flush()
+		c.walkChildren(n)
+		c.flush()
+	case atom.Td, atom.Th:
+		if n.PrevSibling != nil {
+			c.inline.WriteString(" | ")
+		}
+		c.walkChildren(n)
+	case atom.Br:
+		c.inline.WriteString("\n")
+	case atom.Hr:
+		c.flush()
+		if c.markdown {
+			c.addBlock("---")
+		} else {
+			c.addBlock(strings.Repeat("-", c.lineWidth(0, 40)))
+		}
+	case atom.Blockquote:
+		c.flush()
+		c.quote++
+		c.walkChildren(n)
+		c.flush()
+		c.quote--
+	case atom.Ul, atom.Ol:
+		c.flush()
+		if len(c.lists) == 0 {
+			defer c.startGroup()()
+		}
+		c.lists = append(c.lists, &list{ordered: n.DataAtom == atom.Ol})
+		c.walkChildren(n)
+		c.flush()
+		c.lists = c.lists[:len(c.lists)-1]
+	case atom.Li:
+		c.flush()
+		if len(c.lists) == 0 {
+			defer c.startGroup()()
+			c.lists = append(c.lists, &list{})
+			defer func() { c.lists = c.lists[:len(c.lists)-1] }()
+		}
+		l := c.lists[len(c.lists)-1]
+		l.count++
+		l.marker = "- "
+		if l.ordered {
+			l.marker = fmt.Sprintf("%d. ", l.count)
+		}
+		l.used = false
+		c.walkChildren(n)
+		c.flush()
+	case atom.Pre:
+		c.flush()
+		c.preformatted(textContent(n))
+	case atom.A:
+		c.link(n)
+	case atom.Img:
+		c.image(n)
+	case atom.Strong, atom.B:
+		c.emphasis(n, "**")
+	case atom.Em, atom.I:
+		c.emphasis(n, "*")
+	case atom.Code:
+		if c.code > 0 {
+			c.walkChildren(n)
+			return
+		}
+		c.code++
+		c.span(n, codeSpan)
+		c.code--
+	default:
+		c.walkChildren(n)
+	}
+}
+
+func (c *converter) writeText(s string) {
+	if s == "" {
+		return
+	}
+
+	if isSpace(s[0]) && c.inline.Len() > 0 && !endsWithSpace(c.inline.Bytes()) {
+		c.inline.WriteByte(' ')
+	}
+
+	fields := strings.Fields(s)
+	for i, field := range fields {
+		if i > 0 {
+			c.inline.WriteByte(' ')
+		}
+		if c.markdown && c.code == 0 {
+			field = markdownEscaper.Replace(field)
+		}
+		c.inline.WriteString(field)
+	}
+
+	if len(fields) > 0 && isSpace(s[len(s)-1]) {
+		c.inline.WriteByte(' ')
+	}
+}
+
+func (c *converter) emphasis(n *html.Node, marker string) {
+	if c.code > 0 {
+		c.walkChildren(n)
+		return
+	}
+	c.span(n, func(text string) string { return marker + text + marker })
+}
+
+// span renders the children of n as inline Markdown wrapped by wrap.
+func (c *converter) span(n *html.Node, wrap func(string) string) {
+	if !c.markdown {
+		c.walkChildren(n)
+		return
+	}
+
+	start := c.inline.Len()
+	c.walkChildren(n)
+	raw := c.inline.String()[start:]
+	text := strings.TrimSpace(raw)
+	c.inline.Truncate(start)
+	if raw == "" {
+		return
+	}
+
+	// Markers must touch the emphasized text, so surrounding whitespace
+	// goes outside them.
+	if isSpace(raw[0]) {
+		c.inline.WriteByte(' ')
+	}
+	if text == "" {
+		return
+	}
+	c.inline.WriteString(wrap(text))
+	if endsWithSpace([]byte(raw)) {
+		c.inline.WriteByte(' ')
+	}
+}
+
+func (c *converter) link(n *html.Node) {
+	href := strings.TrimSpace(attr(n, "href"))
+	if href == "" || strings.HasPrefix(href, "#") || strings.HasPrefix(href, "javascript:") {
+		c.walkChildren(n)
+		return
+	}
+
+	start := c.inline.Len()
+	c.walkChildren(n)
+	raw := c.inline.String()[start:]
+	label := strings.TrimSpace(raw)
+	c.inline.Truncate(start)
+	defer func() {
+		if endsWithSpace([]byte(raw)) {
+			c.inline.WriteByte(' ')
+		}
+	}()
+
+	ref := c.linkRef(href)
+	switch {
+	case c.markdown && label == "":
+		fmt.Fprintf(&c.inline, "<%s>", href)
+	case c.markdown:
+		fmt.Fprintf(&c.inline, "[%s][%d]", label, ref)
+	case label == "":
+		fmt.Fprintf(&c.inline, "[%d]", ref)
+	default:
+		fmt.Fprintf(&c.inline, "%s[%d]", label, ref)
+	}
+}
+
+func (c *converter) image(n *html.Node) {
+	src := attr(n, "src")
+	alt := strings.TrimSpace(attr(n, "alt"))
+	if src == "" {
+		return
+	}
+
+	if c.markdown {
+		fmt.Fprintf(&c.inline, "![%s](%s)", markdownEscaper.Replace(alt), src)
+		return
+	}
+	if alt == "" {
+		c.inline.WriteString("[image]")
+		return
+	}
+	fmt.Fprintf(&c.inline, "[image: %s]", alt)
+}
+
+func (c *converter) linkRef(href string) int {
+	if ref, ok := c.linkRefs[href]; ok {
+		return ref
+	}
+	c.links = append(c.links, href)
+	c.linkRefs[href] = len(c.links)
+	return len(c.links)
+}
+
+func (c *converter) preformatted(text string) {
+	text = strings.Trim(text, "\n")
+	if text == "" {
+		return
+	}
+
+	lines := strings.Split(text, "\n")
+	if c.markdown {
+		lines = append([]string{"```"}, append(lines, "```")...)
+	} else {
+		for i, line := range lines {
+			lines[i] = "    " + line
+		}
+	}
+
+	first, rest := c.prefixes()
+	for i, line := range lines {
+		if i == 0 {
+			lines[i] = strings.TrimRight(first+line, " ")
+		} else {
+			lines[i] = strings.TrimRight(rest+line, " ")
+		}
+	}
+	c.addBlock(strings.Join(lines, "\n"))
+}
+
+// tableRow renders a table row as a Markdown table row. Cells are converted
+// on their own and joined into a single line; the first row of a table is
+// followed by the header separator.
+func (c *converter) tableRow(n *html.Node) {
+	var cells []string
+	for cell := n.FirstChild; cell != nil; cell = cell.NextSibling {
+		if cell.Type != html.ElementNode || (cell.DataAtom != atom.Td && cell.DataAtom != atom.Th) {
+			continue
+		}
+
+		sub := &converter{
+			markdown: true,
+			links:    c.links,
+			linkRefs: c.linkRefs,
+		}
+		sub.walkChildren(cell)
+		sub.flush()
+		c.links = sub.links
+
+		var parts []string
+		for _, b := range sub.blocks {
+			parts = append(parts, strings.Join(strings.Fields(b.text), " "))
+		}
+		cells = append(cells, strings.ReplaceAll(strings.Join(parts, " "), "|", `\|`))
+	}
+	if len(cells) == 0 {
+		return
+	}
+
+	lines := []string{"| " + strings.Join(cells, " | ") + " |"}
+	if c.rows == 0 {
+		lines = append(lines, "|"+strings.Repeat(" --- |", len(cells)))
+	}
+	c.rows++
+
+	first, rest := c.prefixes()
+	for i, line := range lines {
+		if i == 0 {
+			lines[i] = first + line
+		} else {
+			lines[i] = rest + line
+		}
+	}
+	c.blocks = append(c.blocks, block{
+		text:  strings.Join(lines, "\n"),
+		group: c.group,
+	})
+}
+
+// flush turns the pending inline text into a block, applying the current
+// heading, blockquote and list prefixes.
+func (c *converter) flush() {
+	text := c.inline.String()
+	c.inline.Reset()
+
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+
+	if c.heading > 0 {
+		c.addBlock(c.formatHeading(strings.Join(lines, " ")))
+		return
+	}
+
+	// A list item's marker is used by its first block; later blocks of the
+	// same item are separate paragraphs.
+	loose := len(c.lists) > 0 && c.lists[len(c.lists)-1].used
+	first, rest := c.prefixes()
+	width := c.lineWidth(utf8.RuneCountInString(rest), 0)
+
+	var out []string
+	for i, line := range lines {
+		if c.markdown {
+			line = escapeLineStart(line)
+		}
+		wrapped := wrap(line, width)
+		for j, w := range wrapped {
+			prefix := rest
+			if i == 0 && j == 0 {
+				prefix = first
+			}
+			if c.markdown && j == len(wrapped)-1 && i < len(lines)-1 {
+				w += "  "
+			}
+			out = append(out, prefix+w)
+		}
+	}
+
+	c.blocks = append(c.blocks, block{
+		text:  strings.Join(out, "\n"),
+		group: c.group,
+		loose: loose,
+	})
+}
+
+// startGroup starts a new block group and returns a function restoring the
+// previous one.
+func (c *converter) startGroup() func() {
+	previous := c.group
+	c.groups++
+	c.group = c.groups
+	return func() { c.group = previous }
+}
+
+func (c *converter) addBlock(text string) {
+	c.blocks = append(c.blocks, block{text: text})
+}
+
+func (c *converter) formatHeading(text string) string {
+	if c.markdown {
+		return strings.Repeat("#", c.heading) + " " + text
+	}
+
+	first, _ := c.prefixes()
+	underline := "-"
+	if c.heading == 1 {
+		underline = "="
+	}
+	return first + text + "\n" + first + strings.Repeat(underline, utf8.RuneCountInString(text))
+}
+
+// prefixes returns the prefix for the first line of the pending block and
+// the prefix for its continuation lines.
+func (c *converter) prefixes() (string, string) {
+	quote := strings.Repeat("> ", c.quote)
+	first, rest := quote, quote
+
+	for i, l := range c.lists {
+		indent := strings.Repeat(" ", utf8.RuneCountInString(l.marker))
+		if i == len(c.lists)-1 && !l.used && l.marker != "" {
+			first += l.marker
+			l.used = true
+		} else {
+			first += indent
+		}
+		rest += indent
+	}
+
+	return first, rest
+}
+
+func (c *converter) lineWidth(indent, fallback int) int {
+	if c.markdown || c.width <= 0 {
+		return fallback
+	}
+	if c.width-indent < 20 {
+		return 20
+	}
+	return c.width - indent
+}
+
+// codeSpan wraps text in a Markdown code span delimited by a backtick run
+// longer than any inside text.
+func codeSpan(text string) string {
+	longest, run := 0, 0
+	for _, r := range text {
+		if r == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+
+	fence := strings.Repeat("`", longest+1)
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		text = " " + text + " "
+	}
+	return fence + text + fence
+}
+
+// markdownEscaper escapes characters that would start emphasis, code, links
+// or HTML in Markdown text.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"*", `\*`,
+	"_", `\_`,
+	"`", "\\`",
+	"[", `\[`,
+	"]", `\]`,
+	"<", `\<`,
+)
+
+// escapeLineStart escapes text at the start of a Markdown line that would
+// otherwise be read as a heading, quote, list item or rule.
+func escapeLineStart(line string) string {
+	if line == "" {
+		return line
+	}
+	if strings.IndexByte("#>-+=", line[0]) >= 0 {
+		return `\` + line
+	}
+
+	i := 0
+	for i < len(line) && line[i] >= '0' && line[i] <= '9' {
+		i++
+	}
+	if i > 0 && i < len(line) && (line[i] == '.' || line[i] == ')') {
+		return line[:i] + `\` + line[i:]
+	}
+	return line
+}
+
+func wrap(line string, width int) []string {
+	if width <= 0 {
+		return []string{line}
+	}
+
+	var lines []string
+	var current strings.Builder
+	currentWidth := 0
+	for _, word := range strings.Fields(line) {
+		wordWidth := utf8.RuneCountInString(word)
+		if currentWidth > 0 && currentWidth+1+wordWidth > width {
+			lines = append(lines, current.String())
+			current.Reset()
+			currentWidth = 0
+		}
+		if currentWidth > 0 {
+			current.WriteByte(' ')
+			currentWidth++
+		}
+		current.WriteString(word)
+		currentWidth += wordWidth
+	}
+	if currentWidth > 0 {
+		lines = append(lines, current.String())
+	}
+
+	return lines
+}
+
+func textContent(n *html.Node) string {
+	var sb strings.Builder
+	var collect func(*html.Node)
+	collect = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			sb.WriteString(n.Data)
+		}
+		if n.Type == html.ElementNode && n.DataAtom == atom.Br {
+			sb.WriteString("\n")
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			collect(child)
+		}
+	}
+	collect(n)
+	return sb.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func endsWithSpace(b []byte) bool {
+	return len(b) > 0 && isSpace(b[len(b)-1])
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\n' || b == '\t' || b == '\r'
+}
@@ -0,0 +1,148 @@
+package render
+
+import "testing"
+
+func TestMarkdown(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "headings",
+			content: `<h1>Title</h1><p>Intro</p><h3>Section *one*</h3>`,
+			want:    "# Title\n\nIntro\n\n### Section \\*one\\*\n",
+		},
+		{
+			name:    "nested lists",
+			content: `<ul><li>one<ol><li>first</li><li>second</li></ol></li><li>two</li></ul>`,
+			want:    "- one\n  1. first\n  2. second\n- two\n",
+		},
+		{
+			name:    "links and footnotes",
+			content: `<p>See <a href="https://a.example">this</a>, <a href="https://b.example"> that </a> and <a href="https://a.example">again</a>.</p>`,
+			want:    "See [this][1], [that][2] and [again][1].\n\n[1]: https://a.example\n[2]: https://b.example\n",
+		},
+		{
+			name:    "pre blocks",
+			content: "<p>Run:</p><pre><code>go build ./...\n  go vet ./...</code></pre>",
+			want:    "Run:\n\n```\ngo build ./...\n  go vet ./...\n```\n",
+		},
+		{
+			name:    "emphasis with surrounding whitespace",
+			content: `<p>Some<b> bold</b> and <i>italic </i>text, <em> </em>done.</p>`,
+			want:    "Some **bold** and *italic* text, done.\n",
+		},
+		{
+			name:    "intraword emphasis",
+			content: `<p>foo<em>bar</em>baz and un<b>believ</b>able</p>`,
+			want:    "foo*bar*baz and un**believ**able\n",
+		},
+		{
+			name:    "code span containing backticks",
+			content: "<p><code>a`b</code>, <code>`x`</code> and <code>a``b</code></p>",
+			want:    "``a`b``, `` `x` `` and ```a``b```\n",
+		},
+		{
+			name:    "list item with several paragraphs",
+			content: `<ul><li><p>one</p><p>two</p></li><li>three</li></ul>`,
+			want:    "- one\n\n  two\n- three\n",
+		},
+		{
+			name:    "inline code is not escaped",
+			content: `<p>Call <code>a_b(*c)</code> now.</p>`,
+			want:    "Call `a_b(*c)` now.\n",
+		},
+		{
+			name:    "escaped text",
+			content: `<p>1. not a list *star* snake_case</p><p>- dash [x] &lt;tag&gt;</p><blockquote><p># hash</p></blockquote>`,
+			want:    "1\\. not a list \\*star\\* snake\\_case\n\n\\- dash \\[x\\] \\<tag>\n\n> \\# hash\n",
+		},
+		{
+			name:    "tables",
+			content: `<table><tr><th>Name</th><th>a|b</th></tr><tr><td><p>x</p><p>y</p></td><td><a href="https://a.example">link</a></td></tr></table>`,
+			want:    "| Name | a\\|b |\n| --- | --- |\n| x y | [link][1] |\n\n[1]: https://a.example\n",
+		},
+		{
+			name:    "line breaks",
+			content: `<p>one<br>two</p>`,
+			want:    "one  \ntwo\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Markdown(tt.content)
+			if err != nil {
+				t.Fatalf("Markdown() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Markdown() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		width   int
+		want    string
+	}{
+		{
+			name:    "headings",
+			content: `<h1>Title</h1><h2>Section</h2>`,
+			width:   80,
+			want:    "Title\n=====\n\nSection\n-------\n",
+		},
+		{
+			name:    "nested lists",
+			content: `<ol><li>one<ul><li>inner</li></ul></li><li>two</li></ol>`,
+			width:   80,
+			want:    "1. one\n   - inner\n2. two\n",
+		},
+		{
+			name:    "links and footnotes",
+			content: `<p>See <a href="https://a.example">this</a> and <a href="https://a.example"></a>.</p>`,
+			width:   80,
+			want:    "See this[1] and [1].\n\nLinks:\n[1] https://a.example\n",
+		},
+		{
+			name:    "pre blocks",
+			content: "<pre>line one\n  line two</pre>",
+			width:   80,
+			want:    "    line one\n      line two\n",
+		},
+		{
+			name:    "list item with several paragraphs",
+			content: `<ol><li><p>one</p><p>two</p></li><li>three</li></ol>`,
+			width:   80,
+			want:    "1. one\n\n   two\n2. three\n",
+		},
+		{
+			name:    "emphasis is dropped",
+			content: `<p>Some<b> bold</b> *text*</p>`,
+			width:   80,
+			want:    "Some bold *text*\n",
+		},
+		{
+			name:    "wrapping",
+			content: `<p>The quick brown fox jumps over the lazy dog</p><ul><li>a list item that wraps onto the next line</li></ul>`,
+			width:   20,
+			want:    "The quick brown fox\njumps over the lazy\ndog\n\n- a list item that\n  wraps onto the next\n  line\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Text(tt.content, tt.width)
+			if err != nil {
+				t.Fatalf("Text() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Text() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
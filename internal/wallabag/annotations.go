@@ -0,0 +1,143 @@
+package wallabag
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/Strubbl/wallabago/v9"
+	"golang.org/x/net/html"
+)
+
+func GetAnnotations(entryID int) (*wallabago.Annotations, error) {
+	annotations, err := wallabago.GetAnnotations(apiCall, entryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get annotations: %w", err)
+	}
+
+	return &annotations, nil
+}
+
+// CreateAnnotation annotates quote in the entry with text. Wallabag anchors
+// annotations with ranges; one is computed when quote is found within a
+// single element of content, otherwise the annotation is saved without one.
+func CreateAnnotation(entryID int, content, quote, text string) (*wallabago.Annotation, error) {
+	ranges := []wallabago.Range{}
+	if r, ok := quoteRange(content, quote); ok {
+		ranges = append(ranges, r)
+	}
+
+	postData, err := json.Marshal(map[string]any{
+		"quote":  quote,
+		"text":   text,
+		"ranges": ranges,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	annotationsURL := wallabago.LibConfig.WallabagURL + "/api/annotations/" + strconv.Itoa(entryID) + ".json"
+	body, err := apiCall(annotationsURL, http.MethodPost, postData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create annotation: %w", err)
+	}
+
+	var annotation wallabago.Annotation
+	if err := json.Unmarshal(body, &annotation); err != nil {
+		return nil, fmt.Errorf("failed to create annotation: %w", err)
+	}
+
+	return &annotation, nil
+}
+
+func DeleteAnnotation(id int) error {
+	annotationURL := wallabago.LibConfig.WallabagURL + "/api/annotations/" + strconv.Itoa(id) + ".json"
+	if _, err := apiCall(annotationURL, http.MethodDelete, nil); err != nil {
+		return fmt.Errorf("failed to delete annotation: %w", err)
+	}
+
+	return nil
+}
+
+// quoteRange locates quote in the deepest element of content whose text
+// contains it, and describes it the way wallabag's annotator does: an XPath
+// relative to the article content and offsets in UTF-16 code units within
+// the element's text.
+func quoteRange(content, quote string) (wallabago.Range, bool) {
+	if quote == "" {
+		return wallabago.Range{}, false
+	}
+
+	doc, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return wallabago.Range{}, false
+	}
+
+	var root *html.Node
+	var findBody func(n *html.Node)
+	findBody = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "body" {
+			root = n
+			return
+		}
+		for c := n.FirstChild; c != nil && root == nil; c = c.NextSibling {
+			findBody(c)
+		}
+	}
+	findBody(doc)
+	if root == nil {
+		return wallabago.Range{}, false
+	}
+
+	var found *html.Node
+	var path string
+	var walk func(n *html.Node, p string)
+	walk = func(n *html.Node, p string) {
+		counts := map[string]int{}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+			counts[c.Data]++
+			cp := fmt.Sprintf("%s/%s[%d]", p, c.Data, counts[c.Data])
+			if strings.Contains(textContent(c), quote) {
+				found, path = c, cp
+				walk(c, cp)
+				return
+			}
+		}
+	}
+	walk(root, "")
+	if found == nil {
+		return wallabago.Range{}, false
+	}
+
+	text := textContent(found)
+	start := len(utf16.Encode([]rune(text[:strings.Index(text, quote)])))
+	end := start + len(utf16.Encode([]rune(quote)))
+
+	return wallabago.Range{
+		Start:       path,
+		StartOffset: start,
+		End:         path,
+		EndOffset:   end,
+	}, true
+}
+
+func textContent(n *html.Node) string {
+	var sb strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			sb.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return sb.String()
+}
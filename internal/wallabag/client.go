@@ -1,10 +1,21 @@
 package wallabag
 
 import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Strubbl/wallabago/v9"
+
+	"github.com/goofansu/mlwcli/internal/pager"
 )
 
 func LoadConfig(wallabagURL, clientID, clientSecret, userName, userPassword string) {
@@ -12,6 +23,42 @@ func LoadConfig(wallabagURL, clientID, clientSecret, userName, userPassword stri
 	wallabago.SetConfig(cfg)
 }
 
+// apiCall is a wallabago.BodyByteGetter that, unlike wallabago.APICall,
+// returns an error for non-2xx responses.
+func apiCall(apiURL, httpMethod string, postData []byte) ([]byte, error) {
+	req, err := http.NewRequest(httpMethod, apiURL, bytes.NewReader(postData))
+	if err != nil {
+		return nil, err
+	}
+
+	authString, err := wallabago.GetAuthTokenHeader()
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", authString)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("wallabag: %s", resp.Status)
+	}
+
+	return body, nil
+}
+
+func entryURL(id int, suffix string) string {
+	return wallabago.LibConfig.WallabagURL + "/api/entries/" + strconv.Itoa(id) + suffix
+}
+
 func Validate() error {
 	if _, err := wallabago.GetAuthTokenHeader(); err != nil {
 		return fmt.Errorf("failed to authenticate with wallabag: %w", err)
@@ -20,22 +67,118 @@ func Validate() error {
 	return nil
 }
 
-func CreateEntry(url, tags string, archive bool) error {
-	var archiveInt int
-	if archive {
-		archiveInt = 1
+type CreateEntryOptions struct {
+	URL         string
+	Title       string
+	Tags        string
+	Starred     bool
+	Archive     bool
+	Content     string
+	Language    string
+	PublishedAt time.Time
+	Authors     string
+}
+
+// CreateEntry saves a page. When Content is set, wallabag stores it instead
+// of fetching the page itself. If the URL is already saved, wallabag updates
+// that entry instead; starred and archive are only sent when set so an
+// existing entry keeps its state.
+func CreateEntry(opts CreateEntryOptions) (*wallabago.Item, error) {
+	data := map[string]any{
+		"url": opts.URL,
+	}
+	if opts.Starred {
+		data["starred"] = 1
+	}
+	if opts.Archive {
+		data["archive"] = 1
+	}
+	if opts.Title != "" {
+		data["title"] = opts.Title
+	}
+	if opts.Tags != "" {
+		data["tags"] = strings.Join(strings.Fields(opts.Tags), ",")
+	}
+	if opts.Content != "" {
+		data["content"] = opts.Content
+	}
+	if opts.Language != "" {
+		data["language"] = opts.Language
+	}
+	if !opts.PublishedAt.IsZero() {
+		data["published_at"] = opts.PublishedAt.Unix()
+	}
+	if opts.Authors != "" {
+		data["authors"] = opts.Authors
+	}
+
+	postData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
 	}
 
-	commaTags := ""
-	if tags != "" {
-		commaTags = strings.Join(strings.Fields(tags), ",")
+	body, err := apiCall(wallabago.LibConfig.WallabagURL+"/api/entries.json", http.MethodPost, postData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create entry: %w", err)
 	}
 
-	if err := wallabago.PostEntry(url, "", commaTags, 0, archiveInt); err != nil {
-		return fmt.Errorf("failed to create entry: %w", err)
+	var item wallabago.Item
+	if err := json.Unmarshal(body, &item); err != nil {
+		return nil, fmt.Errorf("failed to create entry: %w", err)
 	}
 
-	return nil
+	return &item, nil
+}
+
+// EntriesExist looks up urls by their SHA-1 hash, which wallabag matches
+// against both the final and the originally given URL of each entry. It
+// returns the ID of the entry saved for each URL, or 0 when there is none.
+func EntriesExist(urls []string) (map[string]int, error) {
+	const batchSize = 50
+
+	ids := map[string]int{}
+	for start := 0; start < len(urls); start += batchSize {
+		batch := urls[start:min(start+batchSize, len(urls))]
+
+		values := url.Values{"return_id": {"1"}}
+		hashes := map[string]string{}
+		for _, u := range batch {
+			hash := hashURL(u)
+			hashes[u] = hash
+			values.Add("hashed_urls[]", hash)
+		}
+
+		body, err := apiCall(wallabago.LibConfig.WallabagURL+"/api/entries/exists.json?"+values.Encode(), http.MethodGet, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check entries: %w", err)
+		}
+
+		var result map[string]*int
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, fmt.Errorf("failed to check entries: %w", err)
+		}
+
+		for _, u := range batch {
+			if id := result[hashes[u]]; id != nil {
+				ids[u] = *id
+			} else {
+				ids[u] = 0
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// hashURL hashes u the way wallabag's UrlHasher does: SHA-1 of the URL
+// decoded like PHP's urldecode, so %xx escapes and + are decoded first.
+func hashURL(u string) string {
+	decoded, err := url.QueryUnescape(u)
+	if err != nil {
+		decoded = u
+	}
+	sum := sha1.Sum([]byte(decoded))
+	return hex.EncodeToString(sum[:])
 }
 
 type ListEntriesOptions struct {
@@ -45,6 +188,7 @@ type ListEntriesOptions struct {
 	PerPage int
 	Tags    string
 	Domain  string
+	Detail  string
 }
 
 type ListEntriesResult struct {
@@ -69,7 +213,7 @@ func ListEntries(opts ListEntriesOptions) (*ListEntriesResult, error) {
 		tags,
 		0,
 		0,
-		"",
+		opts.Detail,
 		opts.Domain,
 	)
 	if err != nil {
@@ -81,3 +225,130 @@ func ListEntries(opts ListEntriesOptions) (*ListEntriesResult, error) {
 		Items: entries.Embedded.Items,
 	}, nil
 }
+
+// AllEntries walks all pages of entries matching opts, ignoring opts.Page and
+// opts.PerPage, and returns at most maxItems entries (all when maxItems is 0).
+func AllEntries(opts ListEntriesOptions, maxItems, concurrency int) (*ListEntriesResult, error) {
+	opts.Page = 1
+	opts.PerPage = pager.PageSize
+	first, err := ListEntries(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := pager.Pages(first.Total, maxItems, pager.PageSize)
+	rest, err := pager.Fetch(max(pages-1, 0), concurrency, func(page int) ([]wallabago.Item, error) {
+		pageOpts := opts
+		pageOpts.Page = page + 2
+		result, err := ListEntries(pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		return result.Items, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := append(first.Items, rest...)
+	if maxItems > 0 && len(items) > maxItems {
+		items = items[:maxItems]
+	}
+
+	return &ListEntriesResult{Total: first.Total, Items: items}, nil
+}
+
+func GetEntry(id int) (*wallabago.Item, error) {
+	item, err := wallabago.GetEntry(apiCall, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entry: %w", err)
+	}
+
+	return &item, nil
+}
+
+// ExportEntry returns the entry converted by wallabag to format, such as
+// epub, mobi, pdf, csv, json, txt, xml or md.
+func ExportEntry(id int, format string) ([]byte, error) {
+	data, err := wallabago.ExportEntry(apiCall, id, format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export entry: %w", err)
+	}
+
+	return data, nil
+}
+
+// UpdateEntryOptions holds the fields to change; nil fields are left as they
+// are. Tags are added to the existing ones.
+type UpdateEntryOptions struct {
+	Title          *string
+	Tags           string
+	Starred        *bool
+	Archive        *bool
+	Language       *string
+	PreviewPicture *string
+}
+
+func UpdateEntry(id int, opts UpdateEntryOptions) (*wallabago.Item, error) {
+	data := map[string]any{}
+	if opts.Title != nil {
+		data["title"] = *opts.Title
+	}
+	if opts.Tags != "" {
+		data["tags"] = strings.Join(strings.Fields(opts.Tags), ",")
+	}
+	if opts.Starred != nil {
+		data["starred"] = boolInt(*opts.Starred)
+	}
+	if opts.Archive != nil {
+		data["archive"] = boolInt(*opts.Archive)
+	}
+	if opts.Language != nil {
+		data["language"] = *opts.Language
+	}
+	if opts.PreviewPicture != nil {
+		data["preview_picture"] = *opts.PreviewPicture
+	}
+
+	postData, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := apiCall(entryURL(id, ".json"), http.MethodPatch, postData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update entry: %w", err)
+	}
+
+	var item wallabago.Item
+	if err := json.Unmarshal(body, &item); err != nil {
+		return nil, fmt.Errorf("failed to update entry: %w", err)
+	}
+
+	return &item, nil
+}
+
+func DeleteEntry(id int) error {
+	if _, err := apiCall(entryURL(id, ".json"), http.MethodDelete, nil); err != nil {
+		return fmt.Errorf("failed to delete entry: %w", err)
+	}
+
+	return nil
+}
+
+// ReloadEntry asks wallabag to fetch the content of the entry again.
+// Wallabag answers 304 Not Modified when the content could not be fetched.
+func ReloadEntry(id int) error {
+	if _, err := apiCall(entryURL(id, "/reload.json"), http.MethodPatch, nil); err != nil {
+		return fmt.Errorf("failed to reload entry: %w", err)
+	}
+
+	return nil
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,84 @@
+package wallabag
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/Strubbl/wallabago/v9"
+)
+
+func Tags() ([]wallabago.Tag, error) {
+	tags, err := wallabago.GetTags(apiCall)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// TagCounts returns the number of entries using each tag ID.
+func TagCounts() (map[int]int, error) {
+	result, err := AllEntries(ListEntriesOptions{Archive: -1, Starred: -1, Detail: "metadata"}, 0, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[int]int{}
+	for _, item := range result.Items {
+		for _, tag := range item.Tags {
+			counts[tag.ID]++
+		}
+	}
+
+	return counts, nil
+}
+
+func AddEntryTags(entryID int, tags []string) error {
+	postData, err := json.Marshal(map[string]string{
+		"tags": strings.Join(tags, ","),
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := apiCall(entryURL(entryID, "/tags.json"), http.MethodPost, postData); err != nil {
+		return fmt.Errorf("failed to add tags: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveEntryTag removes the tag with the given label (case-insensitive)
+// from an entry.
+func RemoveEntryTag(entryID int, label string) error {
+	tags, err := wallabago.GetTagsOfEntry(apiCall, entryID)
+	if err != nil {
+		return fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	for _, tag := range tags {
+		if !strings.EqualFold(tag.Label, label) {
+			continue
+		}
+		if _, err := apiCall(entryURL(entryID, "/tags/"+strconv.Itoa(tag.ID)+".json"), http.MethodDelete, nil); err != nil {
+			return fmt.Errorf("failed to remove tag: %w", err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("entry %d has no tag %q", entryID, label)
+}
+
+// DeleteTag removes the tag with the given label from all entries.
+func DeleteTag(label string) error {
+	tagURL := wallabago.LibConfig.WallabagURL + "/api/tag/label.json?" + url.Values{"tag": {label}}.Encode()
+	if _, err := apiCall(tagURL, http.MethodDelete, nil); err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return nil
+}